@@ -0,0 +1,34 @@
+package switchbot
+
+import (
+	"context"
+)
+
+// API is the set of SwitchBot API operations the device and scene structures depend on.
+// It is implemented by Client, and can be implemented by a fake such as switchbottest.Fake for unit tests.
+// A device or scene whose Client is set to a fake sends its requests to the fake instead of the SwitchBot API.
+type API interface {
+	GetDevices() (*GetDevicesResponse, error)
+	GetDevicesContext(ctx context.Context) (*GetDevicesResponse, error)
+	// GetStatus fills the response, such as *BotDeviceStatusResponse, with the status of the device
+	GetStatus(deviceID string, response any) error
+	GetStatusContext(ctx context.Context, deviceID string, response any) error
+	SendCommand(deviceID string, request ControlRequest) (*CommonResponse, error)
+	SendCommandContext(ctx context.Context, deviceID string, request ControlRequest) (*CommonResponse, error)
+	GetScenes() (*GetScenesResponse, error)
+	GetScenesContext(ctx context.Context) (*GetScenesResponse, error)
+	ExecuteScene(sceneID string) (*CommonResponse, error)
+	ExecuteSceneContext(ctx context.Context, sceneID string) (*CommonResponse, error)
+}
+
+var _ API = (*Client)(nil)
+
+// GetStatus fills the response, such as *BotDeviceStatusResponse, with the status of the device
+func (client *Client) GetStatus(deviceID string, response any) error {
+	return client.GetStatusContext(context.Background(), deviceID, response)
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (client *Client) GetStatusContext(ctx context.Context, deviceID string, response any) error {
+	return client.GetRequestContext(ctx, "/devices/"+deviceID+"/status", GetDeviceStatusResponseParser(response))
+}
@@ -0,0 +1,142 @@
+package switchbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultStatusConcurrency is the number of status requests GetAllStatuses sends at the same time by default
+const DefaultStatusConcurrency = 4
+
+// GetAllStatusesOptions represents the options of GetAllStatuses
+type GetAllStatusesOptions struct {
+	// Devices is the device list, such as GetDevicesResponse.Body.DeviceList.
+	// If nil, the device list is retrieved with GetDevices. Devices that do not implement StatusGettable are ignored.
+	Devices []any
+	// Concurrency is the maximum number of status requests in flight. A value of 0 means DefaultStatusConcurrency.
+	Concurrency int
+	// Interval is the minimum time between the starts of two status requests. A value of 0 means no interval.
+	// The daily quota of the RateLimiter of the Client applies in addition to it.
+	Interval time.Duration
+	// SkipCloudServiceDisabled skips the devices whose EnableCloudService is false
+	SkipCloudServiceDisabled bool
+}
+
+// StatusResult represents the status of a device retrieved by GetAllStatuses
+type StatusResult struct {
+	// Body is the status body returned by GetAnyStatusBody, such as *BotDeviceStatusBody. It is nil if Err is set.
+	Body any
+	// Err is the error returned for the device
+	Err error
+}
+
+// statusContextGettable is implemented by the devices whose status can be retrieved
+type statusContextGettable interface {
+	DeviceListItem
+	GetAnyStatusBodyContext(ctx context.Context) (any, error)
+}
+
+// GetAllStatuses retrieves the status of every device in the list concurrently, and returns the result for each device ID.
+// A failure of one device does not stop the others. The results are returned together with an error that joins the errors of the devices.
+// Each device is requested through its own Client, which is this Client for devices returned by its GetDevices.
+func (client *Client) GetAllStatuses(ctx context.Context, options GetAllStatusesOptions) (map[string]*StatusResult, error) {
+	deviceList := options.Devices
+	if deviceList == nil {
+		response, err := client.GetDevicesContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		deviceList = response.Body.DeviceList
+	}
+
+	var devices []statusContextGettable
+	for _, device := range deviceList {
+		gettable, ok := device.(statusContextGettable)
+		if !ok {
+			continue
+		}
+		if reporter, ok := device.(cloudServiceReporter); ok && options.SkipCloudServiceDisabled && !reporter.cloudServiceEnabled() {
+			continue
+		}
+		devices = append(devices, gettable)
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultStatusConcurrency
+	}
+	pacer := &statusPacer{interval: options.Interval}
+
+	results := make([]*StatusResult, len(devices))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(devices); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				result := &StatusResult{}
+				result.Err = pacer.wait(ctx)
+				if result.Err == nil {
+					result.Body, result.Err = devices[index].GetAnyStatusBodyContext(ctx)
+				}
+				results[index] = result
+			}
+		}()
+	}
+	for index := range devices {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	statuses := make(map[string]*StatusResult, len(devices))
+	var errs []error
+	for index, result := range results {
+		deviceID := devices[index].GetDeviceID()
+		statuses[deviceID] = result
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("failed to get status of %s: %w", deviceID, result.Err))
+		}
+	}
+	return statuses, errors.Join(errs...)
+}
+
+// statusPacer spaces the starts of the status requests by the interval
+type statusPacer struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// wait blocks until the next request may start, or until the context is done
+func (pacer *statusPacer) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if pacer.interval <= 0 {
+		return nil
+	}
+
+	pacer.mu.Lock()
+	now := time.Now()
+	start := pacer.next
+	if start.Before(now) {
+		start = now
+	}
+	pacer.next = start.Add(pacer.interval)
+	pacer.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(start))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
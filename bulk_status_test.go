@@ -0,0 +1,99 @@
+package switchbot_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestGetAllStatuses(t *testing.T) {
+	newClient := func(t *testing.T) (*helpers.SwitchBotMock, *switchbot.Client, func()) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterDevicesMock(
+			[]interface{}{
+				map[string]interface{}{"deviceId": "BOT000000001", "deviceType": "Bot", "hubDeviceId": "HUB000000001", "deviceName": "Bot 1", "enableCloudService": true},
+				map[string]interface{}{"deviceId": "BOT000000002", "deviceType": "Bot", "hubDeviceId": "HUB000000001", "deviceName": "Bot 2", "enableCloudService": true},
+				map[string]interface{}{"deviceId": "BOT000000003", "deviceType": "Bot", "hubDeviceId": "HUB000000001", "deviceName": "Bot 3", "enableCloudService": false},
+			},
+			[]interface{}{
+				map[string]interface{}{"deviceId": "02-202008110034-13", "deviceName": "TV", "remoteType": "TV", "hubDeviceId": "HUB000000001"},
+			},
+		)
+		for _, deviceID := range []string{"BOT000000001", "BOT000000002", "BOT000000003"} {
+			switchBotMock.RegisterStatusMock(deviceID, map[string]interface{}{
+				"deviceId":   deviceID,
+				"deviceType": "Bot",
+				"power":      "on",
+				"battery":    90,
+			})
+		}
+		testServer := switchBotMock.NewTestServer()
+
+		// MEMO: Disable retries so that the injected fault is returned as it is.
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(switchbot.RetryPolicy{}))
+		return switchBotMock, client, testServer.Close
+	}
+
+	t.Run("PartialFailure", func(t *testing.T) {
+		switchBotMock, client, closeServer := newClient(t)
+		defer closeServer()
+		switchBotMock.InjectFaults(http.MethodGet, "/devices/BOT000000002/status", helpers.FaultStatusCode(switchbot.StatusCodeDeviceOffline))
+
+		statuses, err := client.GetAllStatuses(context.Background(), switchbot.GetAllStatusesOptions{Concurrency: 2})
+		assert.ErrorIs(t, err, switchbot.ErrDeviceOffline)
+		assert.Len(t, statuses, 3)
+
+		assert.NoError(t, statuses["BOT000000001"].Err)
+		body, ok := statuses["BOT000000001"].Body.(*switchbot.BotDeviceStatusBody)
+		assert.True(t, ok)
+		assert.Equal(t, "on", body.Power)
+
+		assert.ErrorIs(t, statuses["BOT000000002"].Err, switchbot.ErrDeviceOffline)
+		assert.Nil(t, statuses["BOT000000002"].Body)
+		assert.NoError(t, statuses["BOT000000003"].Err)
+	})
+
+	t.Run("SkipCloudServiceDisabled", func(t *testing.T) {
+		switchBotMock, client, closeServer := newClient(t)
+		defer closeServer()
+
+		response, err := client.GetDevices()
+		assert.NoError(t, err)
+		devices := append(response.Body.DeviceList, response.Body.InfraredRemoteList...)
+
+		statuses, err := client.GetAllStatuses(context.Background(), switchbot.GetAllStatusesOptions{
+			Devices:                  devices,
+			Interval:                 10 * time.Millisecond,
+			SkipCloudServiceDisabled: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, statuses, 2)
+		assert.Contains(t, statuses, "BOT000000001")
+		assert.Contains(t, statuses, "BOT000000002")
+		switchBotMock.AssertCallCount(http.MethodGet, "/devices", 1)
+		switchBotMock.AssertCallCount(http.MethodGet, "/devices/BOT000000003/status", 0)
+	})
+
+	t.Run("CanceledContext", func(t *testing.T) {
+		switchBotMock, client, closeServer := newClient(t)
+		defer closeServer()
+
+		response, err := client.GetDevices()
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		statuses, err := client.GetAllStatuses(ctx, switchbot.GetAllStatusesOptions{Devices: response.Body.DeviceList})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Len(t, statuses, 3)
+		for _, status := range statuses {
+			assert.ErrorIs(t, status.Err, context.Canceled)
+		}
+		switchBotMock.AssertCallCount(http.MethodGet, "/devices/BOT000000001/status", 0)
+	})
+}
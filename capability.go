@@ -0,0 +1,211 @@
+package switchbot
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+)
+
+// Toggleable represents a device whose power state can be toggled
+type Toggleable interface {
+	Toggle() (*CommonResponse, error)
+	ToggleContext(ctx context.Context) (*CommonResponse, error)
+}
+
+// Dimmable represents a device whose brightness can be set
+type Dimmable interface {
+	SetBrightness(brightness int) (*CommonResponse, error)
+	SetBrightnessContext(ctx context.Context, brightness int) (*CommonResponse, error)
+}
+
+// ColorSettable represents a device whose color can be set
+type ColorSettable interface {
+	SetColor(color color.RGBA) (*CommonResponse, error)
+	SetColorContext(ctx context.Context, color color.RGBA) (*CommonResponse, error)
+}
+
+// ColorTemperatureSettable represents a device whose color temperature can be set
+type ColorTemperatureSettable interface {
+	SetColorTemperature(colorTemperature int) (*CommonResponse, error)
+	SetColorTemperatureContext(ctx context.Context, colorTemperature int) (*CommonResponse, error)
+}
+
+// Positionable represents a device that opens and closes to a position, such as curtains and blinds
+type Positionable interface {
+	// SetPositionPercent moves the device to the given position, where 0 is fully open and 100 is fully closed
+	SetPositionPercent(position int) (*CommonResponse, error)
+	SetPositionPercentContext(ctx context.Context, position int) (*CommonResponse, error)
+}
+
+// Lockable represents a device that can be locked and unlocked
+type Lockable interface {
+	Lock() (*CommonResponse, error)
+	LockContext(ctx context.Context) (*CommonResponse, error)
+	Unlock() (*CommonResponse, error)
+	UnlockContext(ctx context.Context) (*CommonResponse, error)
+}
+
+// Pausable represents a device whose operation can be paused
+type Pausable interface {
+	Pause() (*CommonResponse, error)
+	PauseContext(ctx context.Context) (*CommonResponse, error)
+}
+
+// Dockable represents a device that can return to its charging dock
+type Dockable interface {
+	Dock() (*CommonResponse, error)
+	DockContext(ctx context.Context) (*CommonResponse, error)
+}
+
+// BatteryReporter represents a status body that reports the battery level of the device
+type BatteryReporter interface {
+	// GetBattery returns the battery level in percent
+	GetBattery() int
+}
+
+var (
+	_ Toggleable = (*CeilingLightDevice)(nil)
+	_ Toggleable = (*PlugMiniDevice)(nil)
+	_ Toggleable = (*StripLightDevice)(nil)
+	_ Toggleable = (*ColorLightDevice)(nil)
+	_ Toggleable = (*RelaySwitch1PMDevice)(nil)
+	_ Toggleable = (*RelaySwitch1Device)(nil)
+
+	_ Dimmable = (*CeilingLightDevice)(nil)
+	_ Dimmable = (*StripLightDevice)(nil)
+	_ Dimmable = (*ColorLightDevice)(nil)
+
+	_ ColorSettable = (*StripLightDevice)(nil)
+	_ ColorSettable = (*ColorLightDevice)(nil)
+
+	_ ColorTemperatureSettable = (*CeilingLightDevice)(nil)
+	_ ColorTemperatureSettable = (*ColorLightDevice)(nil)
+
+	_ Positionable = (*CurtainDevice)(nil)
+	_ Positionable = (*RollerShadeDevice)(nil)
+	_ Positionable = (*BlindTiltDevice)(nil)
+
+	_ Lockable = (*LockDevice)(nil)
+	_ Lockable = (*LockLiteDevice)(nil)
+
+	_ Pausable = (*CurtainDevice)(nil)
+	_ Pausable = (*RobotVacuumCleanerSDevice)(nil)
+	_ Pausable = (*RobotVacuumCleanerComboDevice)(nil)
+	_ Pausable = (*InfraredRemoteDvdPlayerDevice)(nil)
+	_ Pausable = (*InfraredRemoteSpeakerDevice)(nil)
+
+	_ Dockable = (*RobotVacuumCleanerDevice)(nil)
+	_ Dockable = (*RobotVacuumCleanerSDevice)(nil)
+	_ Dockable = (*RobotVacuumCleanerComboDevice)(nil)
+)
+
+// SetPositionPercent sends a command to move the CurtainDevice to the given position in the default mode
+func (device *CurtainDevice) SetPositionPercent(position int) (*CommonResponse, error) {
+	return device.SetPositionPercentContext(context.Background(), position)
+}
+
+// SetPositionPercentContext is the same as SetPositionPercent, but uses the given context for the request
+func (device *CurtainDevice) SetPositionPercentContext(ctx context.Context, position int) (*CommonResponse, error) {
+	return device.SetPositionContext(ctx, CurtainPositionModeDefault, position)
+}
+
+// SetPositionPercent sends a command to move the RollerShadeDevice to the given position
+func (device *RollerShadeDevice) SetPositionPercent(position int) (*CommonResponse, error) {
+	return device.SetPositionPercentContext(context.Background(), position)
+}
+
+// SetPositionPercentContext is the same as SetPositionPercent, but uses the given context for the request
+func (device *RollerShadeDevice) SetPositionPercentContext(ctx context.Context, position int) (*CommonResponse, error) {
+	return device.SetPositionContext(ctx, position)
+}
+
+// SetPositionPercent sends a command to tilt the BlindTiltDevice to the given position.
+// The slats are tilted in the "up" direction, and the position is rounded to an even number as required by the device.
+func (device *BlindTiltDevice) SetPositionPercent(position int) (*CommonResponse, error) {
+	return device.SetPositionPercentContext(context.Background(), position)
+}
+
+// SetPositionPercentContext is the same as SetPositionPercent, but uses the given context for the request
+func (device *BlindTiltDevice) SetPositionPercentContext(ctx context.Context, position int) (*CommonResponse, error) {
+	if position < 0 || position > 100 {
+		return nil, fmt.Errorf("invalid position: %d", position)
+	}
+	// MEMO: The position of BlindTiltDevice is 0 when closed and 100 when open, which is the opposite of Positionable.
+	open := 100 - position
+	return device.SetPositionContext(ctx, "up", open-open%2)
+}
+
+// GetBattery returns the battery level in percent
+func (body *BotDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *CurtainDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *MeterDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *MeterProCo2DeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *LockDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *LockLiteDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *MotionSensorDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *ContactSensorDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *WaterLeakDetectorDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *RobotVacuumCleanerDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *RobotVacuumCleanerSDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *RobotVacuumCleanerComboDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *BatteryCirculatorFanDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *RollerShadeDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
+
+// GetBattery returns the battery level in percent
+func (body *VideoDoorbellDeviceStatusBody) GetBattery() int {
+	return body.Battery
+}
@@ -0,0 +1,105 @@
+package switchbot_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Run("DimAllLights", func(t *testing.T) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterDevicesMock(
+			[]interface{}{
+				map[string]interface{}{"deviceId": "CEILING00001", "deviceType": "Ceiling Light", "hubDeviceId": "HUB000000001", "deviceName": "Ceiling"},
+				map[string]interface{}{"deviceId": "STRIP0000001", "deviceType": "Strip Light", "hubDeviceId": "HUB000000001", "deviceName": "Strip"},
+				map[string]interface{}{"deviceId": "BULB00000001", "deviceType": "Color Bulb", "hubDeviceId": "HUB000000001", "deviceName": "Bulb"},
+				map[string]interface{}{"deviceId": "BOT000000001", "deviceType": "Bot", "hubDeviceId": "HUB000000001", "deviceName": "Bot"},
+			},
+			[]interface{}{},
+		)
+		for _, deviceID := range []string{"CEILING00001", "STRIP0000001", "BULB00000001"} {
+			switchBotMock.RegisterCommandMock(deviceID, `{"commandType":"command","command":"setBrightness","parameter":"30"}`)
+		}
+		testServer := switchBotMock.NewTestServer()
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+		response, err := client.GetDevices()
+		assert.NoError(t, err)
+
+		lights := switchbot.DevicesOf[switchbot.Dimmable](response.Collection())
+		assert.Len(t, lights, 3)
+		for _, light := range lights {
+			_, err := light.SetBrightness(30)
+			assert.NoError(t, err)
+		}
+
+		for _, deviceID := range []string{"CEILING00001", "STRIP0000001", "BULB00000001"} {
+			switchBotMock.AssertCallCount(http.MethodPost, "/devices/"+deviceID+"/commands", 1)
+		}
+	})
+
+	t.Run("Positionable", func(t *testing.T) {
+		testDataList := []struct {
+			name         string
+			device       func(client *switchbot.Client) switchbot.Positionable
+			position     int
+			expectedBody string
+		}{
+			{
+				name: "CurtainDevice",
+				device: func(client *switchbot.Client) switchbot.Positionable {
+					return &switchbot.CurtainDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"}, Client: client}}
+				},
+				position:     30,
+				expectedBody: `{"commandType":"command","command":"setPosition","parameter":"0,ff,30"}`,
+			},
+			{
+				name: "RollerShadeDevice",
+				device: func(client *switchbot.Client) switchbot.Positionable {
+					return &switchbot.RollerShadeDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"}, Client: client}}
+				},
+				position:     30,
+				expectedBody: `{"commandType":"command","command":"setPosition","parameter":"30"}`,
+			},
+			{
+				name: "BlindTiltDevice",
+				device: func(client *switchbot.Client) switchbot.Positionable {
+					return &switchbot.BlindTiltDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"}, Client: client}}
+				},
+				position:     25,
+				expectedBody: `{"commandType":"command","command":"setPosition","parameter":"up;74"}`,
+			},
+		}
+
+		for _, testData := range testDataList {
+			t.Run(testData.name, func(t *testing.T) {
+				switchBotMock := helpers.NewSwitchBotMock(t)
+				switchBotMock.RegisterCommandMock("ABCDEF123456", testData.expectedBody)
+				testServer := switchBotMock.NewTestServer()
+				defer testServer.Close()
+
+				client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+				_, err := testData.device(client).SetPositionPercent(testData.position)
+				assert.NoError(t, err)
+				switchBotMock.AssertCallCount(http.MethodPost, "/devices/ABCDEF123456/commands", 1)
+
+				_, err = testData.device(client).SetPositionPercent(101)
+				assert.ErrorContains(t, err, "invalid position")
+			})
+		}
+	})
+
+	t.Run("BatteryReporter", func(t *testing.T) {
+		var reporter switchbot.BatteryReporter = &switchbot.MeterDeviceStatusBody{Battery: 87}
+		assert.Equal(t, 87, reporter.GetBattery())
+
+		var status any = &switchbot.Hub2DeviceStatusBody{}
+		_, ok := status.(switchbot.BatteryReporter)
+		assert.False(t, ok)
+	})
+}
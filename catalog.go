@@ -0,0 +1,297 @@
+package switchbot
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// CatalogCommand is a command method of a device structure
+type CatalogCommand struct {
+	// Name is the name of the method, such as "TurnOn". The XxxContext variant is also available.
+	Name string `json:"name"`
+	// Parameters is the list of the Go types of the method parameters, such as "int"
+	Parameters []string `json:"parameters"`
+	// ParameterSchema is the JSON Schema of the ExecCommand parameter for this command, with "command" fixed to Name.
+	// It is nil if the command cannot be sent with ExecCommand.
+	ParameterSchema json.RawMessage `json:"parameterSchema,omitempty"`
+}
+
+// CatalogEntry describes what the library supports for a deviceType or remoteType
+type CatalogEntry struct {
+	// Type is the deviceType of a physical device or the remoteType of an infrared remote device
+	Type string `json:"type"`
+	// InfraredRemote is true if Type is a remoteType
+	InfraredRemote bool `json:"infraredRemote"`
+	// Struct is the name of the device structure, such as "BotDevice"
+	Struct string `json:"struct"`
+	// Commands is the list of command methods of the device structure
+	Commands []CatalogCommand `json:"commands"`
+	// CommandParameterSchema is the JSON Schema accepted by ExecCommand, or nil if ExecCommand is not implemented
+	CommandParameterSchema json.RawMessage `json:"commandParameterSchema,omitempty"`
+	// StatusSupported is true if the device structure implements GetStatus
+	StatusSupported bool `json:"statusSupported"`
+	// StatusStruct is the name of the status body structure, such as "BotDeviceStatusBody"
+	StatusStruct string `json:"statusStruct,omitempty"`
+	// StatusFields is the list of JSON field names of the status body, except deviceId, deviceType and hubDeviceId
+	StatusFields []string `json:"statusFields,omitempty"`
+	// Verified is true if the device structure has been tested with an actual device
+	Verified bool `json:"verified"`
+}
+
+// verifiedDeviceTypes and verifiedInfraredRemoteTypes are the types that have been tested with an actual device
+var (
+	verifiedDeviceTypes         = []string{"Bot", "Hub Mini", "Hub 2", "Meter"}
+	verifiedInfraredRemoteTypes = []string{"Air Conditioner", "Light"}
+)
+
+// DeviceCatalog is the list of CatalogEntry for the registered device types
+type DeviceCatalog []CatalogEntry
+
+// Catalog builds the DeviceCatalog of all registered deviceTypes followed by all registered remoteTypes.
+// It is built from the registry and the device structures, so types added with RegisterDeviceType are included.
+func Catalog() DeviceCatalog {
+	catalog := DeviceCatalog{}
+	for _, deviceType := range DeviceTypes() {
+		catalog = append(catalog, newCatalogEntry(deviceType, false, NewDevice(deviceType, nil)))
+	}
+	for _, remoteType := range InfraredRemoteTypes() {
+		catalog = append(catalog, newCatalogEntry(remoteType, true, NewInfraredRemoteDevice(remoteType, nil)))
+	}
+	return catalog
+}
+
+// Lookup returns the CatalogEntry of the given deviceType or remoteType
+func (catalog DeviceCatalog) Lookup(deviceType string) (CatalogEntry, bool) {
+	for _, entry := range catalog {
+		if entry.Type == deviceType {
+			return entry, true
+		}
+	}
+	return CatalogEntry{}, false
+}
+
+// Markdown renders the catalog as the Markdown tables of physical devices and infrared remote devices
+func (catalog DeviceCatalog) Markdown() string {
+	builder := strings.Builder{}
+	builder.WriteString("| Device | Struct | Get Status | Send Command | Verification |\n")
+	builder.WriteString("|:-------|:-------|:----------:|:------------:|:------------:|\n")
+	for _, entry := range catalog {
+		if entry.InfraredRemote {
+			continue
+		}
+		builder.WriteString("| " + entry.Type + " | `" + entry.Struct + "` | " + markdownSupported(entry.StatusSupported) + " | " + markdownSupported(len(entry.Commands) > 0) + " | " + markdownVerified(entry.Verified) + " |\n")
+	}
+
+	builder.WriteString("\n| Virtual Infrared Remote Device | Struct | Send Command | Verification |\n")
+	builder.WriteString("|:-------------------------------|:-------|:------------:|:------------:|\n")
+	for _, entry := range catalog {
+		if !entry.InfraredRemote {
+			continue
+		}
+		builder.WriteString("| " + entry.Type + " | `" + entry.Struct + "` | " + markdownSupported(len(entry.Commands) > 0) + " | " + markdownVerified(entry.Verified) + " |\n")
+	}
+	return builder.String()
+}
+
+func markdownSupported(supported bool) string {
+	if supported {
+		return "✅"
+	}
+	return "-"
+}
+
+func markdownVerified(verified bool) string {
+	if verified {
+		return "✅"
+	}
+	return ""
+}
+
+var (
+	commonResponseType = reflect.TypeOf(&CommonResponse{})
+	errorType          = reflect.TypeOf((*error)(nil)).Elem()
+	commonDeviceType   = reflect.TypeOf(CommonDevice{})
+)
+
+// newCatalogEntry builds the CatalogEntry of the device structure using reflection
+func newCatalogEntry(deviceType string, infraredRemote bool, device any) CatalogEntry {
+	deviceValueType := reflect.TypeOf(device)
+	entry := CatalogEntry{
+		Type:           deviceType,
+		InfraredRemote: infraredRemote,
+		Struct:         deviceValueType.Elem().Name(),
+		Commands:       []CatalogCommand{},
+	}
+	if infraredRemote {
+		entry.Verified = slices.Contains(verifiedInfraredRemoteTypes, deviceType)
+	} else {
+		entry.Verified = slices.Contains(verifiedDeviceTypes, deviceType)
+	}
+
+	for i := 0; i < deviceValueType.NumMethod(); i++ {
+		method := deviceValueType.Method(i)
+		if strings.HasSuffix(method.Name, "Context") || method.Name == "ExecCommand" || !isCommandMethod(method.Type) {
+			continue
+		}
+		// MEMO: In[0] is the receiver.
+		parameters := []string{}
+		for j := 1; j < method.Type.NumIn(); j++ {
+			parameters = append(parameters, method.Type.In(j).String())
+		}
+		entry.Commands = append(entry.Commands, CatalogCommand{Name: method.Name, Parameters: parameters})
+	}
+
+	if executable, ok := device.(ExecutableCommandDevice); ok {
+		if schema, err := executable.GetCommandParameterJSONSchema(); err == nil {
+			entry.CommandParameterSchema = json.RawMessage(schema)
+			if schemas, err := commandParameterSchemas(entry.CommandParameterSchema); err == nil {
+				// MEMO: Some ExecCommand schemas use the API command name, such as "startClean" for StartClean.
+				for i := range entry.Commands {
+					for name, schema := range schemas {
+						if strings.EqualFold(name, entry.Commands[i].Name) {
+							entry.Commands[i].ParameterSchema = schema
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if _, ok := device.(StatusGettable); ok {
+		entry.StatusSupported = true
+		if method, ok := deviceValueType.MethodByName("GetStatus"); ok && method.Type.NumOut() == 2 {
+			if body, ok := method.Type.Out(0).Elem().FieldByName("Body"); ok {
+				bodyType := body.Type
+				if bodyType.Kind() == reflect.Pointer {
+					bodyType = bodyType.Elem()
+				}
+				entry.StatusStruct = bodyType.Name()
+				entry.StatusFields = statusFieldNames(bodyType)
+			}
+		}
+	}
+
+	return entry
+}
+
+// commandParameterSchemas splits the ExecCommand JSON Schema into a schema for each value of "command".
+// Each schema fixes "command" to the value and keeps the properties and if/then conditions that apply to it.
+func commandParameterSchemas(schemaJSON json.RawMessage) (map[string]json.RawMessage, error) {
+	schema := map[string]any{}
+	if err := json.Unmarshal(schemaJSON, &schema); err != nil {
+		return nil, err
+	}
+	properties, _ := schema["properties"].(map[string]any)
+	command, _ := properties["command"].(map[string]any)
+	commandNames, _ := command["enum"].([]any)
+
+	// MEMO: A schema has either a single if/then block or an allOf list of if/then blocks.
+	var conditions []map[string]any
+	if _, ok := schema["if"]; ok {
+		conditions = append(conditions, schema)
+	}
+	allOf, _ := schema["allOf"].([]any)
+	for _, condition := range allOf {
+		if condition, ok := condition.(map[string]any); ok {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	schemas := map[string]json.RawMessage{}
+	for _, commandName := range commandNames {
+		name, ok := commandName.(string)
+		if !ok {
+			continue
+		}
+		commandSchema := map[string]any{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required":             []any{"command"},
+			"properties":           map[string]any{"command": map[string]any{"type": "string", "const": name}},
+		}
+		for _, condition := range conditions {
+			addCommandCondition(commandSchema, properties, name, condition)
+		}
+		encoded, err := json.Marshal(commandSchema)
+		if err != nil {
+			return nil, err
+		}
+		schemas[name] = encoded
+	}
+	return schemas, nil
+}
+
+// addCommandCondition adds the if/then condition to the schema of the command if the condition applies to it.
+// A condition on "command" only makes the properties of then required; a condition on other properties is kept as an if/then block.
+func addCommandCondition(commandSchema map[string]any, properties map[string]any, name string, condition map[string]any) {
+	ifSchema, _ := condition["if"].(map[string]any)
+	thenSchema, _ := condition["then"].(map[string]any)
+	ifProperties, _ := ifSchema["properties"].(map[string]any)
+	commandCondition, _ := ifProperties["command"].(map[string]any)
+	if commandCondition["const"] != name {
+		return
+	}
+
+	commandProperties := commandSchema["properties"].(map[string]any)
+	thenProperties, _ := thenSchema["properties"].(map[string]any)
+	for property := range thenProperties {
+		commandProperties[property] = properties[property]
+	}
+
+	otherIfProperties := map[string]any{}
+	for property, value := range ifProperties {
+		if property != "command" {
+			otherIfProperties[property] = value
+			commandProperties[property] = properties[property]
+		}
+	}
+	if len(otherIfProperties) == 0 {
+		thenRequired, _ := thenSchema["required"].([]any)
+		commandSchema["required"] = append(commandSchema["required"].([]any), thenRequired...)
+		return
+	}
+
+	ifRequired, _ := ifSchema["required"].([]any)
+	otherIfRequired := []any{}
+	for _, property := range ifRequired {
+		if property != "command" {
+			otherIfRequired = append(otherIfRequired, property)
+		}
+	}
+	allOf, _ := commandSchema["allOf"].([]any)
+	commandSchema["allOf"] = append(allOf, map[string]any{
+		"if":   map[string]any{"type": "object", "required": otherIfRequired, "properties": otherIfProperties},
+		"then": thenSchema,
+	})
+}
+
+// isCommandMethod reports whether the method returns (*CommonResponse, error)
+func isCommandMethod(methodType reflect.Type) bool {
+	return methodType.NumOut() == 2 && methodType.Out(0) == commonResponseType && methodType.Out(1) == errorType
+}
+
+// statusFieldNames returns the JSON field names of the status body in the declaration order, except the fields of CommonDevice
+func statusFieldNames(structType reflect.Type) []string {
+	var names []string
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.Anonymous {
+			if field.Type != commonDeviceType && field.Type.Kind() == reflect.Struct {
+				names = append(names, statusFieldNames(field.Type)...)
+			}
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" || !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
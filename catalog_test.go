@@ -0,0 +1,156 @@
+package switchbot_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/kaptinlin/jsonschema"
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+)
+
+// commandNames returns the names of the commands
+func commandNames(commands []switchbot.CatalogCommand) []string {
+	names := []string{}
+	for _, command := range commands {
+		names = append(names, command.Name)
+	}
+	return names
+}
+
+// catalogCommand returns the command of the entry with the name
+func catalogCommand(t *testing.T, entry switchbot.CatalogEntry, name string) switchbot.CatalogCommand {
+	for _, command := range entry.Commands {
+		if command.Name == name {
+			return command
+		}
+	}
+	t.Fatalf("%s has no command %s", entry.Type, name)
+	return switchbot.CatalogCommand{}
+}
+
+// validCommandParameter reports whether the JSON is valid against the parameter schema of the command
+func validCommandParameter(t *testing.T, command switchbot.CatalogCommand, parameterJSON string) bool {
+	schema, err := jsonschema.NewCompiler().Compile(command.ParameterSchema)
+	assert.NoError(t, err)
+	parameter := map[string]any{}
+	assert.NoError(t, json.Unmarshal([]byte(parameterJSON), &parameter))
+	return schema.Validate(parameter).IsValid()
+}
+
+func TestCatalog(t *testing.T) {
+	catalog := switchbot.Catalog()
+	assert.Len(t, catalog, len(switchbot.DeviceTypes())+len(switchbot.InfraredRemoteTypes()))
+
+	t.Run("Bot", func(t *testing.T) {
+		entry, ok := catalog.Lookup("Bot")
+		assert.True(t, ok)
+		assert.Equal(t, "BotDevice", entry.Struct)
+		assert.False(t, entry.InfraredRemote)
+		assert.Equal(t, []string{"Press", "TurnOff", "TurnOn"}, commandNames(entry.Commands))
+		assert.Empty(t, catalogCommand(t, entry, "Press").Parameters)
+		assert.True(t, entry.StatusSupported)
+		assert.True(t, entry.Verified)
+		assert.Equal(t, "BotDeviceStatusBody", entry.StatusStruct)
+		assert.Equal(t, []string{"power", "battery", "version", "deviceMode"}, entry.StatusFields)
+
+		schema := map[string]any{}
+		assert.NoError(t, json.Unmarshal(entry.CommandParameterSchema, &schema))
+		assert.Contains(t, schema, "properties")
+	})
+
+	t.Run("Curtain", func(t *testing.T) {
+		entry, ok := catalog.Lookup("Curtain3")
+		assert.True(t, ok)
+		assert.False(t, entry.Verified)
+		setPosition := catalogCommand(t, entry, "SetPosition")
+		assert.Equal(t, []string{"switchbot.CurtainPositionMode", "int"}, setPosition.Parameters)
+		assert.True(t, validCommandParameter(t, setPosition, `{"command":"SetPosition","mode":"0","position":50}`))
+		assert.False(t, validCommandParameter(t, setPosition, `{"command":"SetPosition","mode":"0"}`))
+		assert.False(t, validCommandParameter(t, setPosition, `{"command":"TurnOn","mode":"0","position":50}`))
+		assert.True(t, validCommandParameter(t, catalogCommand(t, entry, "TurnOn"), `{"command":"TurnOn"}`))
+		// MEMO: SetPositionPercent is not a command of ExecCommand.
+		assert.Nil(t, catalogCommand(t, entry, "SetPositionPercent").ParameterSchema)
+	})
+
+	t.Run("ConditionalParameter", func(t *testing.T) {
+		entry, ok := catalog.Lookup("Keypad")
+		assert.True(t, ok)
+		createKey := catalogCommand(t, entry, "CreateKey")
+		assert.True(t, validCommandParameter(t, createKey, `{"command":"CreateKey","type":"permanent","name":"Guest","password":"123456"}`))
+		assert.False(t, validCommandParameter(t, createKey, `{"command":"CreateKey","type":"timeLimit","name":"Guest","password":"123456"}`))
+		assert.False(t, validCommandParameter(t, createKey, `{"command":"CreateKey","type":"permanent","name":"Guest","password":"123456","id":"1"}`))
+	})
+
+	t.Run("APICommandName", func(t *testing.T) {
+		entry, ok := catalog.Lookup("Robot Vacuum Cleaner K20 Plus Pro")
+		assert.True(t, ok)
+		assert.True(t, validCommandParameter(t, catalogCommand(t, entry, "Dock"), `{"command":"dock"}`))
+	})
+
+	t.Run("NoStatusNoCommand", func(t *testing.T) {
+		entry, ok := catalog.Lookup("Indoor Cam")
+		assert.True(t, ok)
+		assert.False(t, entry.StatusSupported)
+		assert.Empty(t, entry.StatusFields)
+		assert.Empty(t, entry.Commands)
+		assert.Nil(t, entry.CommandParameterSchema)
+	})
+
+	t.Run("InfraredRemote", func(t *testing.T) {
+		entry, ok := catalog.Lookup("Others")
+		assert.True(t, ok)
+		assert.True(t, entry.InfraredRemote)
+		assert.Equal(t, "InfraredRemoteOthersDevice", entry.Struct)
+		assert.Equal(t, []string{"CustomCommand"}, commandNames(entry.Commands))
+		assert.Equal(t, []string{"string"}, entry.Commands[0].Parameters)
+		assert.False(t, entry.StatusSupported)
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		markdown := catalog.Markdown()
+		assert.Contains(t, markdown, "| Garage Door Opener | `GarageDoorOpenerDevice` | ✅ | ✅ |  |\n")
+		assert.Contains(t, markdown, "| Hub Mini | `HubDevice` | - | - | ✅ |\n")
+		assert.Contains(t, markdown, "| Indoor Cam | `IndoorCamDevice` | - | - |  |\n")
+		assert.Contains(t, markdown, "| TV | `InfraredRemoteTVDevice` | ✅ |  |\n")
+		assert.Contains(t, markdown, "| Light | `InfraredRemoteLightDevice` | ✅ | ✅ |\n")
+	})
+
+	_, ok := catalog.Lookup("Unknown")
+	assert.False(t, ok)
+}
+
+var updateReadme = flag.Bool("update-readme", false, "update the catalog tables in README.md and README_ja.md")
+
+const (
+	readmeCatalogBegin = "<!-- BEGIN switchbot.Catalog().Markdown() -->\n"
+	readmeCatalogEnd   = "<!-- END switchbot.Catalog().Markdown() -->\n"
+)
+
+// TestReadmeCatalog checks that the catalog tables in the READMEs are the output of Catalog().Markdown().
+// Run `go test -run TestReadmeCatalog -update-readme` to regenerate them.
+func TestReadmeCatalog(t *testing.T) {
+	expected := switchbot.Catalog().Markdown()
+	for _, path := range []string{"README.md", "README_ja.md"} {
+		t.Run(path, func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			content := string(data)
+
+			before, rest, found := strings.Cut(content, readmeCatalogBegin)
+			assert.True(t, found, "%s has no %q", path, readmeCatalogBegin)
+			actual, after, found := strings.Cut(rest, readmeCatalogEnd)
+			assert.True(t, found, "%s has no %q", path, readmeCatalogEnd)
+
+			if *updateReadme {
+				content = before + readmeCatalogBegin + expected + readmeCatalogEnd + after
+				assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+				return
+			}
+			assert.Equal(t, expected, actual, "run `go test -run TestReadmeCatalog -update-readme` to update %s", path)
+		})
+	}
+}
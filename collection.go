@@ -0,0 +1,178 @@
+package switchbot
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DeviceListItem is implemented by every physical device and infrared remote device returned by GetDevices
+type DeviceListItem interface {
+	DeviceIDGettable
+	GetDeviceName() string
+	// GetDeviceType returns the deviceType of a physical device or the remoteType of an infrared remote device
+	GetDeviceType() string
+	GetHubDeviceID() string
+}
+
+// GetDeviceType returns the deviceType of the device
+func (device *CommonDevice) GetDeviceType() string {
+	return device.DeviceType
+}
+
+// GetHubDeviceID returns the ID of the hub the device is connected to
+func (device *CommonDevice) GetHubDeviceID() string {
+	return device.HubDeviceId
+}
+
+// GetDeviceName returns the name of the device
+func (device *CommonDeviceListItem) GetDeviceName() string {
+	return device.DeviceName
+}
+
+// GetDeviceName returns the name of the device
+func (device *InfraredRemoteDevice) GetDeviceName() string {
+	return device.DeviceName
+}
+
+// GetDeviceType returns the remoteType of the device
+func (device *InfraredRemoteDevice) GetDeviceType() string {
+	return device.RemoteType
+}
+
+// GetHubDeviceID returns the ID of the hub the device is registered to
+func (device *InfraredRemoteDevice) GetHubDeviceID() string {
+	return device.HubDeviceId
+}
+
+// GetDeviceName returns the name of the device
+func (device *InfraredRemoteOthersDevice) GetDeviceName() string {
+	return device.DeviceName
+}
+
+// GetDeviceType returns the remoteType of the device
+func (device *InfraredRemoteOthersDevice) GetDeviceType() string {
+	return device.RemoteType
+}
+
+// GetHubDeviceID returns the ID of the hub the device is registered to
+func (device *InfraredRemoteOthersDevice) GetHubDeviceID() string {
+	return device.HubDeviceId
+}
+
+// DeviceCollection is a list of physical devices and infrared remote devices with lookup helpers
+type DeviceCollection struct {
+	items []DeviceListItem
+}
+
+// NewDeviceCollection creates a DeviceCollection from the given devices.
+// Devices that do not implement DeviceListItem are ignored.
+func NewDeviceCollection(devices ...any) *DeviceCollection {
+	collection := &DeviceCollection{}
+	for _, device := range devices {
+		if item, ok := device.(DeviceListItem); ok {
+			collection.items = append(collection.items, item)
+		}
+	}
+	return collection
+}
+
+// Collection returns a DeviceCollection of the physical devices followed by the infrared remote devices
+func (response *GetDevicesResponse) Collection() *DeviceCollection {
+	devices := append([]any{}, response.Body.DeviceList...)
+	devices = append(devices, response.Body.InfraredRemoteList...)
+	return NewDeviceCollection(devices...)
+}
+
+// All returns all devices in the collection
+func (collection *DeviceCollection) All() []DeviceListItem {
+	return append([]DeviceListItem{}, collection.items...)
+}
+
+// Len returns the number of devices in the collection
+func (collection *DeviceCollection) Len() int {
+	return len(collection.items)
+}
+
+// FindByID returns the device with the given ID.
+// It returns an error that matches ErrDeviceNotFound if there is no such device.
+func (collection *DeviceCollection) FindByID(deviceID string) (DeviceListItem, error) {
+	for _, item := range collection.items {
+		if item.GetDeviceID() == deviceID {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no device with ID %q", ErrDeviceNotFound, deviceID)
+}
+
+// FindByName returns the first device whose name is exactly the given name.
+// It returns an error that matches ErrDeviceNotFound if there is no such device.
+func (collection *DeviceCollection) FindByName(name string) (DeviceListItem, error) {
+	for _, item := range collection.items {
+		if item.GetDeviceName() == name {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no device named %q", ErrDeviceNotFound, name)
+}
+
+// FindByNameIgnoreCase returns the first device whose name matches the given name case-insensitively.
+// It returns an error that matches ErrDeviceNotFound if there is no such device.
+func (collection *DeviceCollection) FindByNameIgnoreCase(name string) (DeviceListItem, error) {
+	for _, item := range collection.items {
+		if strings.EqualFold(item.GetDeviceName(), name) {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no device named %q (case-insensitive)", ErrDeviceNotFound, name)
+}
+
+// ByHub returns the devices connected to the hub with the given ID
+func (collection *DeviceCollection) ByHub(hubDeviceID string) *DeviceCollection {
+	return collection.filter(func(item DeviceListItem) bool {
+		return item.GetHubDeviceID() == hubDeviceID
+	})
+}
+
+// ByDeviceType returns the devices with the given deviceType, or remoteType for infrared remote devices
+func (collection *DeviceCollection) ByDeviceType(deviceType string) *DeviceCollection {
+	return collection.filter(func(item DeviceListItem) bool {
+		return item.GetDeviceType() == deviceType
+	})
+}
+
+func (collection *DeviceCollection) filter(match func(item DeviceListItem) bool) *DeviceCollection {
+	filtered := &DeviceCollection{}
+	for _, item := range collection.items {
+		if match(item) {
+			filtered.items = append(filtered.items, item)
+		}
+	}
+	return filtered
+}
+
+// DevicesOf returns the devices in the collection that are of type T, such as *BotDevice or an interface they implement
+func DevicesOf[T any](collection *DeviceCollection) []T {
+	var devices []T
+	for _, item := range collection.items {
+		if device, ok := item.(T); ok {
+			devices = append(devices, device)
+		}
+	}
+	return devices
+}
+
+// FindOf returns the device with the given ID as type T.
+// It returns an error that matches ErrDeviceNotFound if there is no such device, or if the device is not of type T.
+func FindOf[T any](collection *DeviceCollection, deviceID string) (T, error) {
+	var zero T
+	item, err := collection.FindByID(deviceID)
+	if err != nil {
+		return zero, err
+	}
+	device, ok := item.(T)
+	if !ok {
+		return zero, fmt.Errorf("%w: device %q is %T, not %v", ErrDeviceNotFound, deviceID, item, reflect.TypeFor[T]())
+	}
+	return device, nil
+}
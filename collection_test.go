@@ -0,0 +1,127 @@
+package switchbot_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func getTestDeviceCollection(t *testing.T) *switchbot.DeviceCollection {
+	t.Helper()
+
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterDevicesMock(
+		[]interface{}{
+			map[string]interface{}{
+				"deviceId":    "BOT000000001",
+				"deviceType":  "Bot",
+				"hubDeviceId": "HUB000000001",
+				"deviceName":  "Kitchen Bot",
+			},
+			map[string]interface{}{
+				"deviceId":    "BOT000000002",
+				"deviceType":  "Bot",
+				"hubDeviceId": "HUB000000002",
+				"deviceName":  "Bedroom Bot",
+			},
+			map[string]interface{}{
+				"deviceId":    "HUB000000001",
+				"deviceType":  "Hub 2",
+				"hubDeviceId": "000000000000",
+				"deviceName":  "Living Hub",
+			},
+		},
+		[]interface{}{
+			map[string]interface{}{
+				"deviceId":    "02-202008110034-13",
+				"deviceName":  "Living TV",
+				"remoteType":  "TV",
+				"hubDeviceId": "HUB000000001",
+			},
+			map[string]interface{}{
+				"deviceId":    "02-202008110034-14",
+				"deviceName":  "Living Light",
+				"remoteType":  "Light",
+				"hubDeviceId": "HUB000000001",
+			},
+		},
+	)
+	testServer := switchBotMock.NewTestServer()
+	t.Cleanup(testServer.Close)
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	response, err := client.GetDevices()
+	assert.NoError(t, err)
+	return response.Collection()
+}
+
+func TestDeviceCollection(t *testing.T) {
+	devices := getTestDeviceCollection(t)
+	assert.Equal(t, 5, devices.Len())
+
+	t.Run("FindByID", func(t *testing.T) {
+		device, err := devices.FindByID("02-202008110034-13")
+		assert.NoError(t, err)
+		assert.IsType(t, &switchbot.InfraredRemoteTVDevice{}, device)
+
+		_, err = devices.FindByID("UNKNOWN")
+		assert.ErrorIs(t, err, switchbot.ErrDeviceNotFound)
+		assert.ErrorContains(t, err, `no device with ID "UNKNOWN"`)
+	})
+
+	t.Run("FindByName", func(t *testing.T) {
+		device, err := devices.FindByName("Kitchen Bot")
+		assert.NoError(t, err)
+		assert.Equal(t, "BOT000000001", device.GetDeviceID())
+
+		_, err = devices.FindByName("kitchen bot")
+		assert.ErrorIs(t, err, switchbot.ErrDeviceNotFound)
+
+		device, err = devices.FindByNameIgnoreCase("kitchen bot")
+		assert.NoError(t, err)
+		assert.Equal(t, "BOT000000001", device.GetDeviceID())
+
+		_, err = devices.FindByNameIgnoreCase("garage bot")
+		assert.ErrorIs(t, err, switchbot.ErrDeviceNotFound)
+	})
+
+	t.Run("ByHub", func(t *testing.T) {
+		var ids []string
+		for _, device := range devices.ByHub("HUB000000001").All() {
+			ids = append(ids, device.GetDeviceID())
+		}
+		assert.Equal(t, []string{"BOT000000001", "02-202008110034-13", "02-202008110034-14"}, ids)
+	})
+
+	t.Run("ByDeviceType", func(t *testing.T) {
+		assert.Equal(t, 2, devices.ByDeviceType("Bot").Len())
+		assert.Equal(t, 1, devices.ByDeviceType("Light").Len())
+		assert.Equal(t, 0, devices.ByDeviceType("Curtain").Len())
+	})
+
+	t.Run("DevicesOf", func(t *testing.T) {
+		bots := switchbot.DevicesOf[*switchbot.BotDevice](devices)
+		assert.Len(t, bots, 2)
+		assert.Equal(t, "Bedroom Bot", bots[1].DeviceName)
+
+		lights := switchbot.DevicesOf[*switchbot.InfraredRemoteLightDevice](devices.ByHub("HUB000000001"))
+		assert.Len(t, lights, 1)
+
+		statusGettables := switchbot.DevicesOf[switchbot.StatusGettable](devices)
+		assert.Len(t, statusGettables, 3)
+
+		assert.Empty(t, switchbot.DevicesOf[*switchbot.CurtainDevice](devices))
+	})
+
+	t.Run("FindOf", func(t *testing.T) {
+		hub, err := switchbot.FindOf[*switchbot.Hub2Device](devices, "HUB000000001")
+		assert.NoError(t, err)
+		assert.Equal(t, "Living Hub", hub.DeviceName)
+
+		_, err = switchbot.FindOf[*switchbot.BotDevice](devices, "HUB000000001")
+		assert.ErrorIs(t, err, switchbot.ErrDeviceNotFound)
+		assert.ErrorContains(t, err, "is *switchbot.Hub2Device, not *switchbot.BotDevice")
+	})
+}
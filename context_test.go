@@ -0,0 +1,107 @@
+package switchbot_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestContextDeadline(t *testing.T) {
+	blocked := make(chan struct{})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-blocked:
+		}
+	}))
+	defer testServer.Close()
+	defer close(blocked)
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	device := &switchbot.BotDevice{
+		CommonDeviceListItem: switchbot.CommonDeviceListItem{
+			CommonDevice: switchbot.CommonDevice{
+				DeviceID: "ABCDEF123456",
+			},
+			Client: client,
+		},
+	}
+
+	testDataList := []struct {
+		name string
+		call func(ctx context.Context) error
+	}{
+		{
+			name: "GetDevicesContext",
+			call: func(ctx context.Context) error {
+				_, err := client.GetDevicesContext(ctx)
+				return err
+			},
+		},
+		{
+			name: "GetStatusContext",
+			call: func(ctx context.Context) error {
+				_, err := device.GetStatusContext(ctx)
+				return err
+			},
+		},
+		{
+			name: "TurnOnContext",
+			call: func(ctx context.Context) error {
+				_, err := device.TurnOnContext(ctx)
+				return err
+			},
+		},
+		{
+			name: "ExecCommandContext",
+			call: func(ctx context.Context) error {
+				_, err := device.ExecCommandContext(ctx, `{"command":"Press"}`)
+				return err
+			},
+		},
+		{
+			name: "GetScenesContext",
+			call: func(ctx context.Context) error {
+				_, err := client.GetScenesContext(ctx)
+				return err
+			},
+		},
+	}
+
+	for _, testData := range testDataList {
+		t.Run(testData.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			err := testData.call(ctx)
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+			}
+		})
+	}
+}
+
+func TestContextCanceled(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.SendCommandContext(ctx, "ABCDEF123456", switchbot.ControlRequest{
+		CommandType: "command",
+		Command:     "turnOn",
+		Parameter:   "default",
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -1,6 +1,7 @@
 package switchbot
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -23,6 +24,10 @@ type CommonDevice struct {
 	DeviceID    string `json:"deviceId"`
 	DeviceType  string `json:"deviceType"`
 	HubDeviceId string `json:"hubDeviceId"`
+	// Raw is the JSON object the structure was parsed from
+	Raw json.RawMessage `json:"-"`
+	// Extra holds the fields of Raw that are not defined in the structure, such as fields newly added to the SwitchBot API
+	Extra map[string]any `json:"-"`
 }
 
 func (device *CommonDevice) GetDeviceID() string {
@@ -31,7 +36,7 @@ func (device *CommonDevice) GetDeviceID() string {
 
 type CommonDeviceListItem struct {
 	CommonDevice
-	Client             *Client
+	Client             API    `json:"-"`
 	DeviceName         string `json:"deviceName"`
 	EnableCloudService bool   `json:"enableCloudService"`
 }
@@ -221,11 +226,15 @@ type VideoDoorbellDevice struct {
 }
 
 type InfraredRemoteDevice struct {
-	Client      *Client
+	Client      API    `json:"-"`
 	DeviceID    string `json:"deviceId"`
 	DeviceName  string `json:"deviceName"`
 	RemoteType  string `json:"remoteType"`
 	HubDeviceId string `json:"hubDeviceId"`
+	// Raw is the JSON object the structure was parsed from
+	Raw json.RawMessage `json:"-"`
+	// Extra holds the fields of Raw that are not defined in the structure, such as fields newly added to the SwitchBot API
+	Extra map[string]any `json:"-"`
 }
 
 func (device *InfraredRemoteDevice) GetDeviceID() string {
@@ -299,11 +308,15 @@ type InfraredRemoteRobotVacuumCleanerDevice struct {
 
 // InfraredRemoteOthersDevice represents an infrared remote-controlled device of other types.
 type InfraredRemoteOthersDevice struct {
-	Client      *Client
+	Client      API    `json:"-"`
 	DeviceID    string `json:"deviceId"`
 	DeviceName  string `json:"deviceName"`
 	RemoteType  string `json:"remoteType"`
 	HubDeviceId string `json:"hubDeviceId"`
+	// Raw is the JSON object the structure was parsed from
+	Raw json.RawMessage `json:"-"`
+	// Extra holds the fields of Raw that are not defined in the structure, such as fields newly added to the SwitchBot API
+	Extra map[string]any `json:"-"`
 }
 
 func (device *InfraredRemoteOthersDevice) GetDeviceID() string {
@@ -317,136 +330,33 @@ func GetDevicesResponseParser(response *GetDevicesResponse) ResponseParser {
 			return err
 		}
 
+		// MEMO: Keep each item as it is in the response so that Raw is not affected by re-encoding.
+		rawResponse := struct {
+			Body struct {
+				DeviceList         []json.RawMessage `json:"deviceList"`
+				InfraredRemoteList []json.RawMessage `json:"infraredRemoteList"`
+			} `json:"body"`
+		}{}
+		err = json.Unmarshal(bodyBytes, &rawResponse)
+		if err != nil {
+			return err
+		}
+
 		// Parse the device list
 		var parsedDevices []interface{}
-		for _, deviceInterface := range response.Body.DeviceList {
+		for i, deviceInterface := range response.Body.DeviceList {
 			device, ok := deviceInterface.(map[string]interface{})
 			if !ok {
 				return fmt.Errorf("failed to cast device to map[string]interface{}")
 			}
-			jsonString, err := json.Marshal(device)
-			if err != nil {
-				return err
-			}
+			jsonString := rawResponse.Body.DeviceList[i]
 
 			deviceType, ok := device["deviceType"].(string)
 			if !ok {
 				return fmt.Errorf("failed to cast deviceType to string")
 			}
 
-			var parsed interface{}
-			switch deviceType {
-			case "Bot":
-				parsed = &BotDevice{}
-				parsed.(*BotDevice).Client = client
-			case "Curtain", "Curtain3":
-				parsed = &CurtainDevice{}
-				parsed.(*CurtainDevice).Client = client
-			case "Hub", "Hub Plus", "Hub Mini":
-				parsed = &HubDevice{}
-				parsed.(*HubDevice).Client = client
-			case "Hub 2":
-				parsed = &Hub2Device{}
-				parsed.(*Hub2Device).Client = client
-			case "Hub 3":
-				parsed = &Hub3Device{}
-				parsed.(*Hub3Device).Client = client
-			case "Meter", "MeterPlus", "WoIOSensor", "MeterPro":
-				parsed = &MeterDevice{}
-				parsed.(*MeterDevice).Client = client
-			case "MeterPro(CO2)":
-				parsed = &MeterProCo2Device{}
-				parsed.(*MeterProCo2Device).Client = client
-			case "Smart Lock", "Smart Lock Pro", "Smart Lock Ultra":
-				parsed = &LockDevice{}
-				parsed.(*LockDevice).Client = client
-			case "Smart Lock Lite":
-				parsed = &LockLiteDevice{}
-				parsed.(*LockLiteDevice).Client = client
-			case "Keypad", "Keypad Touch", "Keypad Vision":
-				parsed = &KeypadDevice{}
-				parsed.(*KeypadDevice).Client = client
-			case "Remote":
-				parsed = &RemoteDevice{}
-				parsed.(*RemoteDevice).Client = client
-			case "Motion Sensor":
-				parsed = &MotionSensorDevice{}
-				parsed.(*MotionSensorDevice).Client = client
-			case "Contact Sensor":
-				parsed = &ContactSensorDevice{}
-				parsed.(*ContactSensorDevice).Client = client
-			case "Water Detector":
-				parsed = &WaterLeakDetectorDevice{}
-				parsed.(*WaterLeakDetectorDevice).Client = client
-			case "Ceiling Light", "Ceiling Light Pro":
-				parsed = &CeilingLightDevice{}
-				parsed.(*CeilingLightDevice).Client = client
-			case "Plug Mini (US)", "Plug Mini (JP)":
-				parsed = &PlugMiniDevice{}
-				parsed.(*PlugMiniDevice).Client = client
-			case "Plug":
-				parsed = &PlugDevice{}
-				parsed.(*PlugDevice).Client = client
-			case "Strip Light":
-				parsed = &StripLightDevice{}
-				parsed.(*StripLightDevice).Client = client
-			case "Color Bulb", "Floor Lamp", "Strip Light 3":
-				parsed = &ColorLightDevice{}
-				parsed.(*ColorLightDevice).Client = client
-			case "Robot Vacuum Cleaner S1", "Robot Vacuum Cleaner S1 Plus", "K10+", "K10+ Pro":
-				parsed = &RobotVacuumCleanerDevice{}
-				parsed.(*RobotVacuumCleanerDevice).Client = client
-			case "Robot Vacuum Cleaner K10+ Pro Combo", "Robot Vacuum Cleaner K20 Plus Pro":
-				parsed = &RobotVacuumCleanerComboDevice{}
-				parsed.(*RobotVacuumCleanerComboDevice).Client = client
-			case "Robot Vacuum Cleaner S10", "Robot Vacuum Cleaner S20":
-				parsed = &RobotVacuumCleanerSDevice{}
-				parsed.(*RobotVacuumCleanerSDevice).Client = client
-			case "Humidifier":
-				parsed = &HumidifierDevice{}
-				parsed.(*HumidifierDevice).Client = client
-			case "Humidifier2":
-				parsed = &EvaporativeHumidifierDevice{}
-				parsed.(*EvaporativeHumidifierDevice).Client = client
-			case "Air Purifier VOC", "Air Purifier Table VOC", "Air Purifier PM2.5", "Air Purifier Table PM2.5":
-				parsed = &AirPurifierDevice{}
-				parsed.(*AirPurifierDevice).Client = client
-			case "Indoor Cam":
-				parsed = &IndoorCamDevice{}
-				parsed.(*IndoorCamDevice).Client = client
-			case "Pan/Tilt Cam":
-				parsed = &PanTiltCamDevice{}
-				parsed.(*PanTiltCamDevice).Client = client
-			case "Blind Tilt":
-				parsed = &BlindTiltDevice{}
-				parsed.(*BlindTiltDevice).Client = client
-			case "Battery Circulator Fan":
-				parsed = &BatteryCirculatorFanDevice{}
-				parsed.(*BatteryCirculatorFanDevice).Client = client
-			case "Circulator Fan":
-				parsed = &CirculatorFanDevice{}
-				parsed.(*CirculatorFanDevice).Client = client
-			case "Roller Shade":
-				parsed = &RollerShadeDevice{}
-				parsed.(*RollerShadeDevice).Client = client
-			case "Relay Switch 1PM":
-				parsed = &RelaySwitch1PMDevice{}
-				parsed.(*RelaySwitch1PMDevice).Client = client
-			case "Relay Switch 1":
-				parsed = &RelaySwitch1Device{}
-				parsed.(*RelaySwitch1Device).Client = client
-			case "Relay Switch 2PM":
-				parsed = &RelaySwitch2PMDevice{}
-				parsed.(*RelaySwitch2PMDevice).Client = client
-			case "Video Doorbell":
-				parsed = &VideoDoorbellDevice{}
-				parsed.(*VideoDoorbellDevice).Client = client
-			default:
-				parsed = &CommonDeviceListItem{}
-				parsed.(*CommonDeviceListItem).Client = client
-			}
-
-			err = json.Unmarshal(jsonString, parsed)
+			parsed, err := parseDevice(NewDevice(deviceType, client), jsonString)
 			if err != nil {
 				return err
 			}
@@ -456,71 +366,19 @@ func GetDevicesResponseParser(response *GetDevicesResponse) ResponseParser {
 
 		// Set the Client for each InfraredRemoteDevice
 		var parsedInfraredRemoteDevices []interface{}
-		for _, infraredRemoteDeviceInterface := range response.Body.InfraredRemoteList {
+		for i, infraredRemoteDeviceInterface := range response.Body.InfraredRemoteList {
 			infraredRemoteDevice, ok := infraredRemoteDeviceInterface.(map[string]interface{})
 			if !ok {
 				return fmt.Errorf("failed to cast infraredRemoteDevice to map[string]interface{}")
 			}
-			jsonString, err := json.Marshal(infraredRemoteDevice)
-			if err != nil {
-				return err
-			}
+			jsonString := rawResponse.Body.InfraredRemoteList[i]
 
 			remoteType, ok := infraredRemoteDevice["remoteType"].(string)
 			if !ok {
 				return fmt.Errorf("failed to cast remoteType to string")
 			}
 
-			var parsedInfrared interface{}
-			switch remoteType {
-			case "Air Conditioner":
-				parsedInfrared = &InfraredRemoteAirConditionerDevice{}
-				parsedInfrared.(*InfraredRemoteAirConditionerDevice).Client = client
-			case "TV":
-				parsedInfrared = &InfraredRemoteTVDevice{}
-				parsedInfrared.(*InfraredRemoteTVDevice).Client = client
-			case "Light":
-				parsedInfrared = &InfraredRemoteLightDevice{}
-				parsedInfrared.(*InfraredRemoteLightDevice).Client = client
-			case "Streamer":
-				parsedInfrared = &InfraredRemoteStreamerDevice{}
-				parsedInfrared.(*InfraredRemoteStreamerDevice).Client = client
-			case "Set Top Box":
-				parsedInfrared = &InfraredRemoteSetTopBoxDevice{}
-				parsedInfrared.(*InfraredRemoteSetTopBoxDevice).Client = client
-			case "DVD Player":
-				parsedInfrared = &InfraredRemoteDvdPlayerDevice{}
-				parsedInfrared.(*InfraredRemoteDvdPlayerDevice).Client = client
-			case "Fan":
-				parsedInfrared = &InfraredRemoteFanDevice{}
-				parsedInfrared.(*InfraredRemoteFanDevice).Client = client
-			case "Projector":
-				parsedInfrared = &InfraredRemoteProjectorDevice{}
-				parsedInfrared.(*InfraredRemoteProjectorDevice).Client = client
-			case "Camera":
-				parsedInfrared = &InfraredRemoteCameraDevice{}
-				parsedInfrared.(*InfraredRemoteCameraDevice).Client = client
-			case "Air Purifier":
-				parsedInfrared = &InfraredRemoteAirPurifierDevice{}
-				parsedInfrared.(*InfraredRemoteAirPurifierDevice).Client = client
-			case "Speaker":
-				parsedInfrared = &InfraredRemoteSpeakerDevice{}
-				parsedInfrared.(*InfraredRemoteSpeakerDevice).Client = client
-			case "Water Heater":
-				parsedInfrared = &InfraredRemoteWaterHeaterDevice{}
-				parsedInfrared.(*InfraredRemoteWaterHeaterDevice).Client = client
-			case "Robot Vacuum Cleaner":
-				parsedInfrared = &InfraredRemoteRobotVacuumCleanerDevice{}
-				parsedInfrared.(*InfraredRemoteRobotVacuumCleanerDevice).Client = client
-			case "Others":
-				parsedInfrared = &InfraredRemoteOthersDevice{}
-				parsedInfrared.(*InfraredRemoteOthersDevice).Client = client
-			default:
-				parsedInfrared = &InfraredRemoteDevice{}
-				parsedInfrared.(*InfraredRemoteDevice).Client = client
-			}
-
-			err = json.Unmarshal(jsonString, parsedInfrared)
+			parsedInfrared, err := parseDevice(NewInfraredRemoteDevice(remoteType, client), jsonString)
 			if err != nil {
 				return err
 			}
@@ -532,9 +390,27 @@ func GetDevicesResponseParser(response *GetDevicesResponse) ResponseParser {
 	}
 }
 
+// parseDevice unmarshals the JSON object of a device list item into the device structure created by the registry
+func parseDevice(device any, raw json.RawMessage) (any, error) {
+	err := json.Unmarshal(raw, device)
+	if err != nil {
+		return nil, err
+	}
+	err = fillRaw(device, raw)
+	if err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
 func (client *Client) GetDevices() (*GetDevicesResponse, error) {
+	return client.GetDevicesContext(context.Background())
+}
+
+// GetDevicesContext is the same as GetDevices, but uses the given context for the request
+func (client *Client) GetDevicesContext(ctx context.Context) (*GetDevicesResponse, error) {
 	response := &GetDevicesResponse{}
-	err := client.GetRequest("/devices", GetDevicesResponseParser(response))
+	err := client.GetRequestContext(ctx, "/devices", GetDevicesResponseParser(response))
 	if err != nil {
 		return nil, err
 	}
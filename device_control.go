@@ -1,6 +1,7 @@
 package switchbot
 
 import (
+	"context"
 	"fmt"
 	"image/color"
 	"regexp"
@@ -19,28 +20,43 @@ type ControlRequest struct {
 }
 
 // sendDefaultParameterCommand sends a command with the parameter set to "default"
-func sendDefaultParameterCommand(client *Client, deviceID, command string) (*CommonResponse, error) {
+func sendDefaultParameterCommand(ctx context.Context, client API, deviceID, command string) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     command,
 		Parameter:   "default",
 	}
-	return client.SendCommand(deviceID, request)
+	return client.SendCommandContext(ctx, deviceID, request)
 }
 
 // TurnOn sends a command to turn on the BotDevice
 func (device *BotDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *BotDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the BotDevice
 func (device *BotDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *BotDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // Press sends a command to press the BotDevice
 func (device *BotDevice) Press() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "press")
+	return device.PressContext(context.Background())
+}
+
+// PressContext is the same as Press, but uses the given context for the request
+func (device *BotDevice) PressContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "press")
 }
 
 type CurtainPositionMode string
@@ -53,6 +69,11 @@ const (
 
 // SetPosition sends a command to set the position of the CurtainDevice
 func (device *CurtainDevice) SetPosition(mode CurtainPositionMode, position int) (*CommonResponse, error) {
+	return device.SetPositionContext(context.Background(), mode, position)
+}
+
+// SetPositionContext is the same as SetPosition, but uses the given context for the request
+func (device *CurtainDevice) SetPositionContext(ctx context.Context, mode CurtainPositionMode, position int) (*CommonResponse, error) {
 	if mode != CurtainPositionModePerformance && mode != CurtainPositionModeSilent && mode != CurtainPositionModeDefault {
 		return nil, fmt.Errorf("invalid mode: %s", mode)
 	}
@@ -66,42 +87,77 @@ func (device *CurtainDevice) SetPosition(mode CurtainPositionMode, position int)
 		// MEMO: The "index0" parameter is unclear, so it is fixed to 0 for now.
 		Parameter: fmt.Sprintf("0,%s,%d", mode, position),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the CurtainDevice
 func (device *CurtainDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *CurtainDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the CurtainDevice
 func (device *CurtainDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *CurtainDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // Pause sends a command to pause the CurtainDevice
 func (device *CurtainDevice) Pause() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "pause")
+	return device.PauseContext(context.Background())
+}
+
+// PauseContext is the same as Pause, but uses the given context for the request
+func (device *CurtainDevice) PauseContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "pause")
 }
 
 // Lock sends a command to lock the LockDevice
 func (device *LockDevice) Lock() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "lock")
+	return device.LockContext(context.Background())
+}
+
+// LockContext is the same as Lock, but uses the given context for the request
+func (device *LockDevice) LockContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "lock")
 }
 
 // Unlock sends a command to unlock the LockDevice
 func (device *LockDevice) Unlock() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "unlock")
+	return device.UnlockContext(context.Background())
+}
+
+// UnlockContext is the same as Unlock, but uses the given context for the request
+func (device *LockDevice) UnlockContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "unlock")
 }
 
 // Lock sends a command to lock the LockLiteDevice
 func (device *LockLiteDevice) Lock() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "lock")
+	return device.LockContext(context.Background())
+}
+
+// LockContext is the same as Lock, but uses the given context for the request
+func (device *LockLiteDevice) LockContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "lock")
 }
 
 // Unlock sends a command to unlock the LockLiteDevice
 func (device *LockLiteDevice) Unlock() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "unlock")
+	return device.UnlockContext(context.Background())
+}
+
+// UnlockContext is the same as Unlock, but uses the given context for the request
+func (device *LockLiteDevice) UnlockContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "unlock")
 }
 
 type KeypadKey struct {
@@ -119,7 +175,8 @@ func NewKeypadKey(name string, keyType string, password string, startTime int64,
 	}
 	passwordRegexp := regexp.MustCompile(`^\d{6,12}$`)
 	if !passwordRegexp.MatchString(password) {
-		return nil, fmt.Errorf("invalid password: %s", password)
+		// MEMO: The password is not included in the error so that it is not leaked to the logs.
+		return nil, fmt.Errorf("invalid password: must be 6 to 12 digits")
 	}
 	if keyType == "timeLimit" || keyType == "disposable" {
 		if startTime <= 0 || endTime <= 0 {
@@ -141,18 +198,30 @@ func NewKeypadKey(name string, keyType string, password string, startTime int64,
 
 // CreateKey sends a command to create a new key for the KeypadDevice.
 // Note: The result of this request is not returned by this method but is asynchronously returned via a webhook.
+// Use webhook.KeypadCreateKeyResultEvent of the webhook package to receive the result.
 func (device *KeypadDevice) CreateKey(keypadKey *KeypadKey) (*CommonResponse, error) {
+	return device.CreateKeyContext(context.Background(), keypadKey)
+}
+
+// CreateKeyContext is the same as CreateKey, but uses the given context for the request
+func (device *KeypadDevice) CreateKeyContext(ctx context.Context, keypadKey *KeypadKey) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "createKey",
 		Parameter:   keypadKey,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // DeleteKey sends a command to delete a key from the KeypadDevice.
 // Note: The result of this request is not returned by this method but is asynchronously returned via a webhook.
+// Use webhook.KeypadDeleteKeyResultEvent of the webhook package to receive the result.
 func (device *KeypadDevice) DeleteKey(id string) (*CommonResponse, error) {
+	return device.DeleteKeyContext(context.Background(), id)
+}
+
+// DeleteKeyContext is the same as DeleteKey, but uses the given context for the request
+func (device *KeypadDevice) DeleteKeyContext(ctx context.Context, id string) (*CommonResponse, error) {
 	deleteKeyParameter := struct {
 		Id string `json:"id"`
 	}{
@@ -163,26 +232,46 @@ func (device *KeypadDevice) DeleteKey(id string) (*CommonResponse, error) {
 		Command:     "deleteKey",
 		Parameter:   deleteKeyParameter,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the CeilingLightDevice
 func (device *CeilingLightDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *CeilingLightDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the CeilingLightDevice
 func (device *CeilingLightDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *CeilingLightDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // Toggle sends a command to toggle the CeilingLightDevice
 func (device *CeilingLightDevice) Toggle() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "toggle")
+	return device.ToggleContext(context.Background())
+}
+
+// ToggleContext is the same as Toggle, but uses the given context for the request
+func (device *CeilingLightDevice) ToggleContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "toggle")
 }
 
 // SetBrightness sends a command to set the brightness of the CeilingLightDevice
 func (device *CeilingLightDevice) SetBrightness(brightness int) (*CommonResponse, error) {
+	return device.SetBrightnessContext(context.Background(), brightness)
+}
+
+// SetBrightnessContext is the same as SetBrightness, but uses the given context for the request
+func (device *CeilingLightDevice) SetBrightnessContext(ctx context.Context, brightness int) (*CommonResponse, error) {
 	if brightness < 1 || brightness > 100 {
 		return nil, fmt.Errorf("invalid brightness: %d", brightness)
 	}
@@ -191,11 +280,16 @@ func (device *CeilingLightDevice) SetBrightness(brightness int) (*CommonResponse
 		Command:     "setBrightness",
 		Parameter:   fmt.Sprintf("%d", brightness),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetColorTemperature sends a command to set the color temperature of the CeilingLightDevice
 func (device *CeilingLightDevice) SetColorTemperature(colorTemperature int) (*CommonResponse, error) {
+	return device.SetColorTemperatureContext(context.Background(), colorTemperature)
+}
+
+// SetColorTemperatureContext is the same as SetColorTemperature, but uses the given context for the request
+func (device *CeilingLightDevice) SetColorTemperatureContext(ctx context.Context, colorTemperature int) (*CommonResponse, error) {
 	if colorTemperature < 2700 || colorTemperature > 6500 {
 		return nil, fmt.Errorf("invalid colorTemperature: %d", colorTemperature)
 	}
@@ -204,51 +298,96 @@ func (device *CeilingLightDevice) SetColorTemperature(colorTemperature int) (*Co
 		Command:     "setColorTemperature",
 		Parameter:   fmt.Sprintf("%d", colorTemperature),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the PlugMiniDevice
 func (device *PlugMiniDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *PlugMiniDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the PlugMiniDevice
 func (device *PlugMiniDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *PlugMiniDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // Toggle sends a command to toggle the PlugMiniDevice
 func (device *PlugMiniDevice) Toggle() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "toggle")
+	return device.ToggleContext(context.Background())
+}
+
+// ToggleContext is the same as Toggle, but uses the given context for the request
+func (device *PlugMiniDevice) ToggleContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "toggle")
 }
 
 // TurnOn sends a command to turn on the PlugDevice
 func (device *PlugDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *PlugDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the PlugDevice
 func (device *PlugDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *PlugDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // TurnOn sends a command to turn on the StripLightDevice
 func (device *StripLightDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *StripLightDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the StripLightDevice
 func (device *StripLightDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *StripLightDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // Toggle sends a command to toggle the StripLightDevice
 func (device *StripLightDevice) Toggle() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "toggle")
+	return device.ToggleContext(context.Background())
+}
+
+// ToggleContext is the same as Toggle, but uses the given context for the request
+func (device *StripLightDevice) ToggleContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "toggle")
 }
 
 // SetBrightness sends a command to set the brightness of the StripLightDevice
 func (device *StripLightDevice) SetBrightness(brightness int) (*CommonResponse, error) {
+	return device.SetBrightnessContext(context.Background(), brightness)
+}
+
+// SetBrightnessContext is the same as SetBrightness, but uses the given context for the request
+func (device *StripLightDevice) SetBrightnessContext(ctx context.Context, brightness int) (*CommonResponse, error) {
 	if brightness < 1 || brightness > 100 {
 		return nil, fmt.Errorf("invalid brightness: %d", brightness)
 	}
@@ -257,36 +396,61 @@ func (device *StripLightDevice) SetBrightness(brightness int) (*CommonResponse,
 		Command:     "setBrightness",
 		Parameter:   fmt.Sprintf("%d", brightness),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetColor sends a command to set the color of the StripLightDevice
 func (device *StripLightDevice) SetColor(color color.RGBA) (*CommonResponse, error) {
+	return device.SetColorContext(context.Background(), color)
+}
+
+// SetColorContext is the same as SetColor, but uses the given context for the request
+func (device *StripLightDevice) SetColorContext(ctx context.Context, color color.RGBA) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "setColor",
 		Parameter:   fmt.Sprintf("%d:%d:%d", color.R, color.G, color.B),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the ColorLightDevice
 func (device *ColorLightDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *ColorLightDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the ColorLightDevice
 func (device *ColorLightDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *ColorLightDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // Toggle sends a command to toggle the ColorLightDevice
 func (device *ColorLightDevice) Toggle() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "toggle")
+	return device.ToggleContext(context.Background())
+}
+
+// ToggleContext is the same as Toggle, but uses the given context for the request
+func (device *ColorLightDevice) ToggleContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "toggle")
 }
 
 // SetBrightness sends a command to set the brightness of the ColorLightDevice
 func (device *ColorLightDevice) SetBrightness(brightness int) (*CommonResponse, error) {
+	return device.SetBrightnessContext(context.Background(), brightness)
+}
+
+// SetBrightnessContext is the same as SetBrightness, but uses the given context for the request
+func (device *ColorLightDevice) SetBrightnessContext(ctx context.Context, brightness int) (*CommonResponse, error) {
 	if brightness < 1 || brightness > 100 {
 		return nil, fmt.Errorf("invalid brightness: %d", brightness)
 	}
@@ -295,21 +459,31 @@ func (device *ColorLightDevice) SetBrightness(brightness int) (*CommonResponse,
 		Command:     "setBrightness",
 		Parameter:   fmt.Sprintf("%d", brightness),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetColor sends a command to set the color of the ColorLightDevice
 func (device *ColorLightDevice) SetColor(color color.RGBA) (*CommonResponse, error) {
+	return device.SetColorContext(context.Background(), color)
+}
+
+// SetColorContext is the same as SetColor, but uses the given context for the request
+func (device *ColorLightDevice) SetColorContext(ctx context.Context, color color.RGBA) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "setColor",
 		Parameter:   fmt.Sprintf("%d:%d:%d", color.R, color.G, color.B),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetColorTemperature sends a command to set the color temperature of the ColorLightDevice
 func (device *ColorLightDevice) SetColorTemperature(colorTemperature int) (*CommonResponse, error) {
+	return device.SetColorTemperatureContext(context.Background(), colorTemperature)
+}
+
+// SetColorTemperatureContext is the same as SetColorTemperature, but uses the given context for the request
+func (device *ColorLightDevice) SetColorTemperatureContext(ctx context.Context, colorTemperature int) (*CommonResponse, error) {
 	if colorTemperature < 2700 || colorTemperature > 6500 {
 		return nil, fmt.Errorf("invalid colorTemperature: %d", colorTemperature)
 	}
@@ -318,22 +492,37 @@ func (device *ColorLightDevice) SetColorTemperature(colorTemperature int) (*Comm
 		Command:     "setColorTemperature",
 		Parameter:   fmt.Sprintf("%d", colorTemperature),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // Start sends a command to start vacuuming the RobotVacuumCleanerDevice
 func (device *RobotVacuumCleanerDevice) Start() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "start")
+	return device.StartContext(context.Background())
+}
+
+// StartContext is the same as Start, but uses the given context for the request
+func (device *RobotVacuumCleanerDevice) StartContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "start")
 }
 
 // Stop sends a command to stop vacuuming the RobotVacuumCleanerDevice
 func (device *RobotVacuumCleanerDevice) Stop() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "stop")
+	return device.StopContext(context.Background())
+}
+
+// StopContext is the same as Stop, but uses the given context for the request
+func (device *RobotVacuumCleanerDevice) StopContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "stop")
 }
 
 // Dock sends a command to return the RobotVacuumCleanerDevice to its charging dock.
 func (device *RobotVacuumCleanerDevice) Dock() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "dock")
+	return device.DockContext(context.Background())
+}
+
+// DockContext is the same as Dock, but uses the given context for the request
+func (device *RobotVacuumCleanerDevice) DockContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "dock")
 }
 
 // RobotVacuumCleanerPowerLevel represents the power level of the RobotVacuumCleanerDevice.
@@ -348,6 +537,11 @@ const (
 
 // SetPowerLevel sends a command to set the suction power level of the RobotVacuumCleanerDevice.
 func (device *RobotVacuumCleanerDevice) SetPowerLevel(powerLevel RobotVacuumCleanerPowerLevel) (*CommonResponse, error) {
+	return device.SetPowerLevelContext(context.Background(), powerLevel)
+}
+
+// SetPowerLevelContext is the same as SetPowerLevel, but uses the given context for the request
+func (device *RobotVacuumCleanerDevice) SetPowerLevelContext(ctx context.Context, powerLevel RobotVacuumCleanerPowerLevel) (*CommonResponse, error) {
 	if powerLevel < 0 || powerLevel > 3 {
 		return nil, fmt.Errorf("invalid powerLevel: %d", powerLevel)
 	}
@@ -356,7 +550,7 @@ func (device *RobotVacuumCleanerDevice) SetPowerLevel(powerLevel RobotVacuumClea
 		Command:     "PowLevel",
 		Parameter:   fmt.Sprintf("%d", powerLevel),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // FloorCleaningAction represents the action to be performed floor cleaning mode.
@@ -412,31 +606,56 @@ func NewFloorCleaningParam(fanLevel int, waterLevel int, times int) (*FloorClean
 
 // StartClean sends a command to start cleaning the RobotVacuumCleanerSDevice.
 func (device *RobotVacuumCleanerSDevice) StartClean(startFloorCleaningParam *StartFloorCleaningParam) (*CommonResponse, error) {
+	return device.StartCleanContext(context.Background(), startFloorCleaningParam)
+}
+
+// StartCleanContext is the same as StartClean, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) StartCleanContext(ctx context.Context, startFloorCleaningParam *StartFloorCleaningParam) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "startClean",
 		Parameter:   startFloorCleaningParam,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // AddWaterForHumi sends a command to refill the mind-blowing Evaporative Humidifier (Auto-refill) in the RobotVacuumCleanerSDevice.
 func (device *RobotVacuumCleanerSDevice) AddWaterForHumi() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "addWaterForHumi")
+	return device.AddWaterForHumiContext(context.Background())
+}
+
+// AddWaterForHumiContext is the same as AddWaterForHumi, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) AddWaterForHumiContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "addWaterForHumi")
 }
 
 // Pause sends a command to pause the RobotVacuumCleanerSDevice.
 func (device *RobotVacuumCleanerSDevice) Pause() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "pause")
+	return device.PauseContext(context.Background())
+}
+
+// PauseContext is the same as Pause, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) PauseContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "pause")
 }
 
 // Dock sends a command to return the RobotVacuumCleanerSDevice to its charging dock.
 func (device *RobotVacuumCleanerSDevice) Dock() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "dock")
+	return device.DockContext(context.Background())
+}
+
+// DockContext is the same as Dock, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) DockContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "dock")
 }
 
 // SetVolume sends a command to set the volume of the RobotVacuumCleanerSDevice.
 func (device *RobotVacuumCleanerSDevice) SetVolume(volume int) (*CommonResponse, error) {
+	return device.SetVolumeContext(context.Background(), volume)
+}
+
+// SetVolumeContext is the same as SetVolume, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) SetVolumeContext(ctx context.Context, volume int) (*CommonResponse, error) {
 	if volume < 0 || volume > 100 {
 		return nil, fmt.Errorf("invalid volume: %d", volume)
 	}
@@ -445,7 +664,7 @@ func (device *RobotVacuumCleanerSDevice) SetVolume(volume int) (*CommonResponse,
 		Command:     "setVolume",
 		Parameter:   fmt.Sprintf("%d", volume),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 type SelfCleaningMode int
@@ -458,6 +677,11 @@ const (
 
 // SelfClean sends a command to start self-cleaning the RobotVacuumCleanerSDevice.
 func (device *RobotVacuumCleanerSDevice) SelfClean(mode SelfCleaningMode) (*CommonResponse, error) {
+	return device.SelfCleanContext(context.Background(), mode)
+}
+
+// SelfCleanContext is the same as SelfClean, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) SelfCleanContext(ctx context.Context, mode SelfCleaningMode) (*CommonResponse, error) {
 	if mode < 1 || mode > 3 {
 		return nil, fmt.Errorf("invalid mode: %d", mode)
 	}
@@ -466,17 +690,22 @@ func (device *RobotVacuumCleanerSDevice) SelfClean(mode SelfCleaningMode) (*Comm
 		Command:     "selfClean",
 		Parameter:   fmt.Sprintf("%d", mode),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // ChangeParam sends a command to change the cleaning parameters of the RobotVacuumCleanerSDevice.
 func (device *RobotVacuumCleanerSDevice) ChangeParam(floorCleaningParam *FloorCleaningParam) (*CommonResponse, error) {
+	return device.ChangeParamContext(context.Background(), floorCleaningParam)
+}
+
+// ChangeParamContext is the same as ChangeParam, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) ChangeParamContext(ctx context.Context, floorCleaningParam *FloorCleaningParam) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "changeParam",
 		Parameter:   floorCleaningParam,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // FloorCleaningActionCombo represents the action to be performed in floor cleaning mode for Combo devices.
@@ -530,36 +759,56 @@ func NewStartFloorCleaningComboParam(action FloorCleaningActionCombo, fanLevel i
 
 // StartClean sends a command to start cleaning the RobotVacuumCleanerComboDevice.
 func (device *RobotVacuumCleanerComboDevice) StartClean(startFloorCleaningParam *StartFloorCleaningComboParam) (*CommonResponse, error) {
+	return device.StartCleanContext(context.Background(), startFloorCleaningParam)
+}
+
+// StartCleanContext is the same as StartClean, but uses the given context for the request
+func (device *RobotVacuumCleanerComboDevice) StartCleanContext(ctx context.Context, startFloorCleaningParam *StartFloorCleaningComboParam) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "startClean",
 		Parameter:   startFloorCleaningParam,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // Pause sends a command to pause the RobotVacuumCleanerComboDevice.
 func (device *RobotVacuumCleanerComboDevice) Pause() (*CommonResponse, error) {
+	return device.PauseContext(context.Background())
+}
+
+// PauseContext is the same as Pause, but uses the given context for the request
+func (device *RobotVacuumCleanerComboDevice) PauseContext(ctx context.Context) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "pause",
 		Parameter:   "default",
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // Dock sends a command to return the RobotVacuumCleanerComboDevice to its charging dock.
 func (device *RobotVacuumCleanerComboDevice) Dock() (*CommonResponse, error) {
+	return device.DockContext(context.Background())
+}
+
+// DockContext is the same as Dock, but uses the given context for the request
+func (device *RobotVacuumCleanerComboDevice) DockContext(ctx context.Context) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "dock",
 		Parameter:   "default",
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetVolume sends a command to set the volume of the RobotVacuumCleanerComboDevice.
 func (device *RobotVacuumCleanerComboDevice) SetVolume(volume int) (*CommonResponse, error) {
+	return device.SetVolumeContext(context.Background(), volume)
+}
+
+// SetVolumeContext is the same as SetVolume, but uses the given context for the request
+func (device *RobotVacuumCleanerComboDevice) SetVolumeContext(ctx context.Context, volume int) (*CommonResponse, error) {
 	if volume < 0 || volume > 100 {
 		return nil, fmt.Errorf("volume must be between 0 and 100")
 	}
@@ -569,27 +818,42 @@ func (device *RobotVacuumCleanerComboDevice) SetVolume(volume int) (*CommonRespo
 		Command:     "setVolume",
 		Parameter:   fmt.Sprintf("%d", volume),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // ChangeParam sends a command to change the cleaning parameters of the RobotVacuumCleanerComboDevice.
 func (device *RobotVacuumCleanerComboDevice) ChangeParam(floorCleaningParam *FloorCleaningParam) (*CommonResponse, error) {
+	return device.ChangeParamContext(context.Background(), floorCleaningParam)
+}
+
+// ChangeParamContext is the same as ChangeParam, but uses the given context for the request
+func (device *RobotVacuumCleanerComboDevice) ChangeParamContext(ctx context.Context, floorCleaningParam *FloorCleaningParam) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "changeParam",
 		Parameter:   floorCleaningParam,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the HumidifierDevice
 func (device *HumidifierDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *HumidifierDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the HumidifierDevice
 func (device *HumidifierDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *HumidifierDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 type HumidifierMode int
@@ -603,6 +867,11 @@ const (
 
 // SetMode sends a command to set the mode of the HumidifierDevice
 func (device *HumidifierDevice) SetMode(mode HumidifierMode) (*CommonResponse, error) {
+	return device.SetModeContext(context.Background(), mode)
+}
+
+// SetModeContext is the same as SetMode, but uses the given context for the request
+func (device *HumidifierDevice) SetModeContext(ctx context.Context, mode HumidifierMode) (*CommonResponse, error) {
 	if (mode < 101 || mode > 103) && (mode != 0) {
 		return nil, fmt.Errorf("invalid mode: %d", mode)
 	}
@@ -611,11 +880,16 @@ func (device *HumidifierDevice) SetMode(mode HumidifierMode) (*CommonResponse, e
 		Command:     "setMode",
 		Parameter:   fmt.Sprintf("%d", mode),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetTargetHumidity sends a command to set the target humidity of the HumidifierDevice
 func (device *HumidifierDevice) SetTargetHumidity(targetHumidity int) (*CommonResponse, error) {
+	return device.SetTargetHumidityContext(context.Background(), targetHumidity)
+}
+
+// SetTargetHumidityContext is the same as SetTargetHumidity, but uses the given context for the request
+func (device *HumidifierDevice) SetTargetHumidityContext(ctx context.Context, targetHumidity int) (*CommonResponse, error) {
 	if targetHumidity < 0 || targetHumidity > 100 {
 		return nil, fmt.Errorf("invalid mode: %d", targetHumidity)
 	}
@@ -624,17 +898,27 @@ func (device *HumidifierDevice) SetTargetHumidity(targetHumidity int) (*CommonRe
 		Command:     "setMode",
 		Parameter:   fmt.Sprintf("%d", targetHumidity),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the EvaporativeHumidifierDevice
 func (device *EvaporativeHumidifierDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *EvaporativeHumidifierDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the EvaporativeHumidifierDevice
 func (device *EvaporativeHumidifierDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *EvaporativeHumidifierDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 type EvaporativeHumidifierMode int
@@ -658,6 +942,11 @@ type EvaporativeHumidifierModeParam struct {
 
 // SetMode sends a command to set the mode of the EvaporativeHumidifierDevice
 func (device *EvaporativeHumidifierDevice) SetMode(mode EvaporativeHumidifierMode, targetHumidity int) (*CommonResponse, error) {
+	return device.SetModeContext(context.Background(), mode, targetHumidity)
+}
+
+// SetModeContext is the same as SetMode, but uses the given context for the request
+func (device *EvaporativeHumidifierDevice) SetModeContext(ctx context.Context, mode EvaporativeHumidifierMode, targetHumidity int) (*CommonResponse, error) {
 	if mode < 1 || mode > 8 {
 		return nil, fmt.Errorf("invalid mode: %d", mode)
 	}
@@ -672,27 +961,42 @@ func (device *EvaporativeHumidifierDevice) SetMode(mode EvaporativeHumidifierMod
 			TargetHumidity: targetHumidity,
 		},
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetChildLock sends a command to set the child lock of the EvaporativeHumidifierDevice
 func (device *EvaporativeHumidifierDevice) SetChildLock(flag bool) (*CommonResponse, error) {
+	return device.SetChildLockContext(context.Background(), flag)
+}
+
+// SetChildLockContext is the same as SetChildLock, but uses the given context for the request
+func (device *EvaporativeHumidifierDevice) SetChildLockContext(ctx context.Context, flag bool) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "setChildLock",
 		Parameter:   fmt.Sprintf("%t", flag),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the AirPurifierDevice
 func (device *AirPurifierDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *AirPurifierDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the AirPurifierDevice
 func (device *AirPurifierDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *AirPurifierDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 type AirPurifierMode int
@@ -711,6 +1015,11 @@ type AirPurifierModeParameter struct {
 
 // SetMode sends a command to set the mode of the AirPurifierDevice
 func (device *AirPurifierDevice) SetMode(mode AirPurifierMode, fanLevel int) (*CommonResponse, error) {
+	return device.SetModeContext(context.Background(), mode, fanLevel)
+}
+
+// SetModeContext is the same as SetMode, but uses the given context for the request
+func (device *AirPurifierDevice) SetModeContext(ctx context.Context, mode AirPurifierMode, fanLevel int) (*CommonResponse, error) {
 	if mode < 1 || mode > 4 {
 		return nil, fmt.Errorf("invalid mode: %d", mode)
 	}
@@ -727,11 +1036,16 @@ func (device *AirPurifierDevice) SetMode(mode AirPurifierMode, fanLevel int) (*C
 			FanGear: fanLevel,
 		},
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetChildLock sends a command to set the child lock of the AirPurifierDevice
 func (device *AirPurifierDevice) SetChildLock(flag bool) (*CommonResponse, error) {
+	return device.SetChildLockContext(context.Background(), flag)
+}
+
+// SetChildLockContext is the same as SetChildLock, but uses the given context for the request
+func (device *AirPurifierDevice) SetChildLockContext(ctx context.Context, flag bool) (*CommonResponse, error) {
 	flagInt := 0
 	if flag {
 		flagInt = 1
@@ -741,11 +1055,16 @@ func (device *AirPurifierDevice) SetChildLock(flag bool) (*CommonResponse, error
 		Command:     "setChildLock",
 		Parameter:   flagInt,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetPosition sends a command to set the position of the BlindTiltDevice
 func (device *BlindTiltDevice) SetPosition(direction string, position int) (*CommonResponse, error) {
+	return device.SetPositionContext(context.Background(), direction, position)
+}
+
+// SetPositionContext is the same as SetPosition, but uses the given context for the request
+func (device *BlindTiltDevice) SetPositionContext(ctx context.Context, direction string, position int) (*CommonResponse, error) {
 	if direction != "up" && direction != "down" {
 		return nil, fmt.Errorf("invalid direction: %s", direction)
 	}
@@ -760,32 +1079,57 @@ func (device *BlindTiltDevice) SetPosition(direction string, position int) (*Com
 		Command:     "setPosition",
 		Parameter:   fmt.Sprintf("%s;%d", direction, position),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // FullyOpen sends a command to fully open the BlindTiltDevice
 func (device *BlindTiltDevice) FullyOpen() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "fullyOpen")
+	return device.FullyOpenContext(context.Background())
+}
+
+// FullyOpenContext is the same as FullyOpen, but uses the given context for the request
+func (device *BlindTiltDevice) FullyOpenContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "fullyOpen")
 }
 
 // CloseUp sends a command to close up the BlindTiltDevice
 func (device *BlindTiltDevice) CloseUp() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "closeUp")
+	return device.CloseUpContext(context.Background())
+}
+
+// CloseUpContext is the same as CloseUp, but uses the given context for the request
+func (device *BlindTiltDevice) CloseUpContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "closeUp")
 }
 
 // CloseDown sends a command to close down the BlindTiltDevice
 func (device *BlindTiltDevice) CloseDown() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "closeDown")
+	return device.CloseDownContext(context.Background())
+}
+
+// CloseDownContext is the same as CloseDown, but uses the given context for the request
+func (device *BlindTiltDevice) CloseDownContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "closeDown")
 }
 
 // TurnOn sends a command to turn on the BatteryCirculatorFanDevice
 func (device *BatteryCirculatorFanDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *BatteryCirculatorFanDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the BatteryCirculatorFanDevice
 func (device *BatteryCirculatorFanDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *BatteryCirculatorFanDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 type CirculatorNightLightMode string
@@ -798,6 +1142,11 @@ const (
 
 // SetNightLightMode sends a command to set the night light mode of the BatteryCirculatorFanDevice
 func (device *BatteryCirculatorFanDevice) SetNightLightMode(mode CirculatorNightLightMode) (*CommonResponse, error) {
+	return device.SetNightLightModeContext(context.Background(), mode)
+}
+
+// SetNightLightModeContext is the same as SetNightLightMode, but uses the given context for the request
+func (device *BatteryCirculatorFanDevice) SetNightLightModeContext(ctx context.Context, mode CirculatorNightLightMode) (*CommonResponse, error) {
 	if mode != CirculatorNightLightModeTurnOff && mode != CirculatorNightLightModeTurnBright && mode != CirculatorNightLightModeTurnDim {
 		return nil, fmt.Errorf("invalid mode: %s", mode)
 	}
@@ -806,7 +1155,7 @@ func (device *BatteryCirculatorFanDevice) SetNightLightMode(mode CirculatorNight
 		Command:     "setNightLightMode",
 		Parameter:   mode,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 type CirculatorWindMode string
@@ -820,6 +1169,11 @@ const (
 
 // SetWindMode sends a command to set the wind mode of the BatteryCirculatorFanDevice
 func (device *BatteryCirculatorFanDevice) SetWindMode(mode CirculatorWindMode) (*CommonResponse, error) {
+	return device.SetWindModeContext(context.Background(), mode)
+}
+
+// SetWindModeContext is the same as SetWindMode, but uses the given context for the request
+func (device *BatteryCirculatorFanDevice) SetWindModeContext(ctx context.Context, mode CirculatorWindMode) (*CommonResponse, error) {
 	if mode != CirculatorWindModeDirect && mode != CirculatorWindModeNatural && mode != CirculatorWindModeSleep && mode != CirculatorWindModeBaby {
 		return nil, fmt.Errorf("invalid mode: %s", mode)
 	}
@@ -828,11 +1182,16 @@ func (device *BatteryCirculatorFanDevice) SetWindMode(mode CirculatorWindMode) (
 		Command:     "setWindMode",
 		Parameter:   mode,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetWindSpeed sends a command to set the wind speed of the BatteryCirculatorFanDevice
 func (device *BatteryCirculatorFanDevice) SetWindSpeed(speed int) (*CommonResponse, error) {
+	return device.SetWindSpeedContext(context.Background(), speed)
+}
+
+// SetWindSpeedContext is the same as SetWindSpeed, but uses the given context for the request
+func (device *BatteryCirculatorFanDevice) SetWindSpeedContext(ctx context.Context, speed int) (*CommonResponse, error) {
 	if speed < 1 || speed > 100 {
 		return nil, fmt.Errorf("invalid speed: %d", speed)
 	}
@@ -841,16 +1200,26 @@ func (device *BatteryCirculatorFanDevice) SetWindSpeed(speed int) (*CommonRespon
 		Command:     "setWindSpeed",
 		Parameter:   fmt.Sprintf("%d", speed),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the CirculatorFanDevice
 func (device *CirculatorFanDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *CirculatorFanDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // SetNightLightMode sends a command to set the night light mode of the CirculatorFanDevice
 func (device *CirculatorFanDevice) SetNightLightMode(mode CirculatorNightLightMode) (*CommonResponse, error) {
+	return device.SetNightLightModeContext(context.Background(), mode)
+}
+
+// SetNightLightModeContext is the same as SetNightLightMode, but uses the given context for the request
+func (device *CirculatorFanDevice) SetNightLightModeContext(ctx context.Context, mode CirculatorNightLightMode) (*CommonResponse, error) {
 	if mode != CirculatorNightLightModeTurnOff && mode != CirculatorNightLightModeTurnBright && mode != CirculatorNightLightModeTurnDim {
 		return nil, fmt.Errorf("invalid mode: %s", mode)
 	}
@@ -859,16 +1228,26 @@ func (device *CirculatorFanDevice) SetNightLightMode(mode CirculatorNightLightMo
 		Command:     "setNightLightMode",
 		Parameter:   mode,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOff sends a command to turn off the CirculatorFanDevice
 func (device *CirculatorFanDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *CirculatorFanDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // SetWindMode sends a command to set the wind mode of the CirculatorFanDevice
 func (device *CirculatorFanDevice) SetWindMode(mode CirculatorWindMode) (*CommonResponse, error) {
+	return device.SetWindModeContext(context.Background(), mode)
+}
+
+// SetWindModeContext is the same as SetWindMode, but uses the given context for the request
+func (device *CirculatorFanDevice) SetWindModeContext(ctx context.Context, mode CirculatorWindMode) (*CommonResponse, error) {
 	if mode != CirculatorWindModeDirect && mode != CirculatorWindModeNatural && mode != CirculatorWindModeSleep && mode != CirculatorWindModeBaby {
 		return nil, fmt.Errorf("invalid mode: %s", mode)
 	}
@@ -877,11 +1256,16 @@ func (device *CirculatorFanDevice) SetWindMode(mode CirculatorWindMode) (*Common
 		Command:     "setWindMode",
 		Parameter:   mode,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetWindSpeed sends a command to set the wind speed of the CirculatorFanDevice
 func (device *CirculatorFanDevice) SetWindSpeed(speed int) (*CommonResponse, error) {
+	return device.SetWindSpeedContext(context.Background(), speed)
+}
+
+// SetWindSpeedContext is the same as SetWindSpeed, but uses the given context for the request
+func (device *CirculatorFanDevice) SetWindSpeedContext(ctx context.Context, speed int) (*CommonResponse, error) {
 	if speed < 1 || speed > 100 {
 		return nil, fmt.Errorf("invalid speed: %d", speed)
 	}
@@ -890,11 +1274,16 @@ func (device *CirculatorFanDevice) SetWindSpeed(speed int) (*CommonResponse, err
 		Command:     "setWindSpeed",
 		Parameter:   fmt.Sprintf("%d", speed),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetPosition sends a command to set the position of the RollerShadeDevice
 func (device *RollerShadeDevice) SetPosition(position int) (*CommonResponse, error) {
+	return device.SetPositionContext(context.Background(), position)
+}
+
+// SetPositionContext is the same as SetPosition, but uses the given context for the request
+func (device *RollerShadeDevice) SetPositionContext(ctx context.Context, position int) (*CommonResponse, error) {
 	if position < 0 || position > 100 {
 		return nil, fmt.Errorf("invalid position: %d", position)
 	}
@@ -903,22 +1292,37 @@ func (device *RollerShadeDevice) SetPosition(position int) (*CommonResponse, err
 		Command:     "setPosition",
 		Parameter:   fmt.Sprintf("%d", position),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the RelaySwitch1PMDevice
 func (device *RelaySwitch1PMDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *RelaySwitch1PMDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the RelaySwitch1PMDevice
 func (device *RelaySwitch1PMDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *RelaySwitch1PMDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // Toggle sends a command to toggle the RelaySwitch1PMDevice
 func (device *RelaySwitch1PMDevice) Toggle() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "toggle")
+	return device.ToggleContext(context.Background())
+}
+
+// ToggleContext is the same as Toggle, but uses the given context for the request
+func (device *RelaySwitch1PMDevice) ToggleContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "toggle")
 }
 
 type RelaySwitchMode int
@@ -932,6 +1336,11 @@ const (
 
 // SetMode sends a command to set the mode of the RelaySwitch1PMDevice
 func (device *RelaySwitch1PMDevice) SetMode(mode RelaySwitchMode) (*CommonResponse, error) {
+	return device.SetModeContext(context.Background(), mode)
+}
+
+// SetModeContext is the same as SetMode, but uses the given context for the request
+func (device *RelaySwitch1PMDevice) SetModeContext(ctx context.Context, mode RelaySwitchMode) (*CommonResponse, error) {
 	if mode < 0 || mode > 3 {
 		return nil, fmt.Errorf("invalid mode: %d", mode)
 	}
@@ -940,26 +1349,46 @@ func (device *RelaySwitch1PMDevice) SetMode(mode RelaySwitchMode) (*CommonRespon
 		Command:     "setMode",
 		Parameter:   fmt.Sprintf("%d", mode),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the RelaySwitch1Device
 func (device *RelaySwitch1Device) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *RelaySwitch1Device) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the RelaySwitch1Device
 func (device *RelaySwitch1Device) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *RelaySwitch1Device) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // Toggle sends a command to toggle the RelaySwitch1Device
 func (device *RelaySwitch1Device) Toggle() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "toggle")
+	return device.ToggleContext(context.Background())
+}
+
+// ToggleContext is the same as Toggle, but uses the given context for the request
+func (device *RelaySwitch1Device) ToggleContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "toggle")
 }
 
 // SetMode sends a command to set the mode of the RelaySwitch1Device
 func (device *RelaySwitch1Device) SetMode(mode RelaySwitchMode) (*CommonResponse, error) {
+	return device.SetModeContext(context.Background(), mode)
+}
+
+// SetModeContext is the same as SetMode, but uses the given context for the request
+func (device *RelaySwitch1Device) SetModeContext(ctx context.Context, mode RelaySwitchMode) (*CommonResponse, error) {
 	if mode < 0 || mode > 3 {
 		return nil, fmt.Errorf("invalid mode: %d", mode)
 	}
@@ -968,16 +1397,21 @@ func (device *RelaySwitch1Device) SetMode(mode RelaySwitchMode) (*CommonResponse
 		Command:     "setMode",
 		Parameter:   fmt.Sprintf("%d", mode),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the specified switch of the RelaySwitch2PMDevice
 // switchNum: 1 for switch 1, 2 for switch 2
 func (device *RelaySwitch2PMDevice) TurnOn(switchNum int) (*CommonResponse, error) {
+	return device.TurnOnContext(context.Background(), switchNum)
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *RelaySwitch2PMDevice) TurnOnContext(ctx context.Context, switchNum int) (*CommonResponse, error) {
 	if err := validate2PMDeviceSwitchNumber(switchNum); err != nil {
 		return nil, err
 	}
-	return device.Client.SendCommand(device.DeviceID, ControlRequest{
+	return device.Client.SendCommandContext(ctx, device.DeviceID, ControlRequest{
 		CommandType: "command",
 		Command:     "turnOn",
 		Parameter:   fmt.Sprintf("%d", switchNum),
@@ -987,10 +1421,15 @@ func (device *RelaySwitch2PMDevice) TurnOn(switchNum int) (*CommonResponse, erro
 // TurnOff sends a command to turn off the specified switch of the RelaySwitch2PMDevice
 // switchNum: 1 for switch 1, 2 for switch 2
 func (device *RelaySwitch2PMDevice) TurnOff(switchNum int) (*CommonResponse, error) {
+	return device.TurnOffContext(context.Background(), switchNum)
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *RelaySwitch2PMDevice) TurnOffContext(ctx context.Context, switchNum int) (*CommonResponse, error) {
 	if err := validate2PMDeviceSwitchNumber(switchNum); err != nil {
 		return nil, err
 	}
-	return device.Client.SendCommand(device.DeviceID, ControlRequest{
+	return device.Client.SendCommandContext(ctx, device.DeviceID, ControlRequest{
 		CommandType: "command",
 		Command:     "turnOff",
 		Parameter:   fmt.Sprintf("%d", switchNum),
@@ -1000,10 +1439,15 @@ func (device *RelaySwitch2PMDevice) TurnOff(switchNum int) (*CommonResponse, err
 // Toggle sends a command to toggle the specified switch of the RelaySwitch2PMDevice
 // switchNum: 1 for switch 1, 2 for switch 2
 func (device *RelaySwitch2PMDevice) Toggle(switchNum int) (*CommonResponse, error) {
+	return device.ToggleContext(context.Background(), switchNum)
+}
+
+// ToggleContext is the same as Toggle, but uses the given context for the request
+func (device *RelaySwitch2PMDevice) ToggleContext(ctx context.Context, switchNum int) (*CommonResponse, error) {
 	if err := validate2PMDeviceSwitchNumber(switchNum); err != nil {
 		return nil, err
 	}
-	return device.Client.SendCommand(device.DeviceID, ControlRequest{
+	return device.Client.SendCommandContext(ctx, device.DeviceID, ControlRequest{
 		CommandType: "command",
 		Command:     "toggle",
 		Parameter:   fmt.Sprintf("%d", switchNum),
@@ -1012,6 +1456,11 @@ func (device *RelaySwitch2PMDevice) Toggle(switchNum int) (*CommonResponse, erro
 
 // SetMode sends a command to set the mode of the RelaySwitch2PMDevice
 func (device *RelaySwitch2PMDevice) SetMode(switchNum int, mode RelaySwitchMode) (*CommonResponse, error) {
+	return device.SetModeContext(context.Background(), switchNum, mode)
+}
+
+// SetModeContext is the same as SetMode, but uses the given context for the request
+func (device *RelaySwitch2PMDevice) SetModeContext(ctx context.Context, switchNum int, mode RelaySwitchMode) (*CommonResponse, error) {
 	if err := validate2PMDeviceSwitchNumber(switchNum); err != nil {
 		return nil, err
 	}
@@ -1023,7 +1472,7 @@ func (device *RelaySwitch2PMDevice) SetMode(switchNum int, mode RelaySwitchMode)
 		Command:     "setMode",
 		Parameter:   fmt.Sprintf("%d,%d", switchNum, mode),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // validate2PMDeviceSwitchNumber checks if the switch number is valid (1 or 2)
@@ -1036,42 +1485,72 @@ func validate2PMDeviceSwitchNumber(switchNumber int) error {
 
 // TurnOn sends a command to turn on the GarageDoorOpenerDevice
 func (device *GarageDoorOpenerDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *GarageDoorOpenerDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the GarageDoorOpenerDevice
 func (device *GarageDoorOpenerDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *GarageDoorOpenerDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 // EnableMotionDetection enables motion detection on the Video Doorbell
 func (device *VideoDoorbellDevice) EnableMotionDetection() (*CommonResponse, error) {
+	return device.EnableMotionDetectionContext(context.Background())
+}
+
+// EnableMotionDetectionContext is the same as EnableMotionDetection, but uses the given context for the request
+func (device *VideoDoorbellDevice) EnableMotionDetectionContext(ctx context.Context) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "enableMotionDetection",
 		Parameter:   "default",
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // DisableMotionDetection disables motion detection on the Video Doorbell
 func (device *VideoDoorbellDevice) DisableMotionDetection() (*CommonResponse, error) {
+	return device.DisableMotionDetectionContext(context.Background())
+}
+
+// DisableMotionDetectionContext is the same as DisableMotionDetection, but uses the given context for the request
+func (device *VideoDoorbellDevice) DisableMotionDetectionContext(ctx context.Context) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "disableMotionDetection",
 		Parameter:   "default",
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // TurnOn sends a command to turn on the InfraredRemoteDevice
 func (device *InfraredRemoteDevice) TurnOn() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOn")
+	return device.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (device *InfraredRemoteDevice) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOn")
 }
 
 // TurnOff sends a command to turn off the InfraredRemoteDevice
 func (device *InfraredRemoteDevice) TurnOff() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "turnOff")
+	return device.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (device *InfraredRemoteDevice) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "turnOff")
 }
 
 type AirConditionerMode int
@@ -1095,6 +1574,13 @@ const (
 // SetAll sends a command to configure all parameters of the InfraredRemoteAirConditionerDevice
 func (device *InfraredRemoteAirConditionerDevice) SetAll(
 	temperatureCelsius int, mode AirConditionerMode, fan AirConditionerFanMode, powerState AirConditionerPowerState,
+) (*CommonResponse, error) {
+	return device.SetAllContext(context.Background(), temperatureCelsius, mode, fan, powerState)
+}
+
+// SetAllContext is the same as SetAll, but uses the given context for the request
+func (device *InfraredRemoteAirConditionerDevice) SetAllContext(
+	ctx context.Context, temperatureCelsius int, mode AirConditionerMode, fan AirConditionerFanMode, powerState AirConditionerPowerState,
 ) (*CommonResponse, error) {
 	if temperatureCelsius < -10 || temperatureCelsius > 40 {
 		return nil, fmt.Errorf("invalid temperatureCelsius: %d", temperatureCelsius)
@@ -1112,134 +1598,254 @@ func (device *InfraredRemoteAirConditionerDevice) SetAll(
 		Command:     "setAll",
 		Parameter:   parameter,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // SetChannel sends a command to set the channel of the InfraredRemoteTVDevice / InfraredRemoteStreamerDevice / InfraredRemoteSetTopBoxDevice
 func (device *InfraredRemoteTVDevice) SetChannel(channel int) (*CommonResponse, error) {
+	return device.SetChannelContext(context.Background(), channel)
+}
+
+// SetChannelContext is the same as SetChannel, but uses the given context for the request
+func (device *InfraredRemoteTVDevice) SetChannelContext(ctx context.Context, channel int) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "SetChannel",
 		Parameter:   fmt.Sprintf("%d", channel),
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 // VolumeAdd sends a command to increase the volume of the InfraredRemoteTVDevice / InfraredRemoteStreamerDevice / InfraredRemoteSetTopBoxDevice
 func (device *InfraredRemoteTVDevice) VolumeAdd() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "volumeAdd")
+	return device.VolumeAddContext(context.Background())
+}
+
+// VolumeAddContext is the same as VolumeAdd, but uses the given context for the request
+func (device *InfraredRemoteTVDevice) VolumeAddContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "volumeAdd")
 }
 
 // VolumeSub sends a command to decrease the volume of the InfraredRemoteTVDevice / InfraredRemoteStreamerDevice / InfraredRemoteSetTopBoxDevice
 func (device *InfraredRemoteTVDevice) VolumeSub() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "volumeSub")
+	return device.VolumeSubContext(context.Background())
+}
+
+// VolumeSubContext is the same as VolumeSub, but uses the given context for the request
+func (device *InfraredRemoteTVDevice) VolumeSubContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "volumeSub")
 }
 
 // ChannelAdd sends a command to increase the channel of the InfraredRemoteTVDevice / InfraredRemoteStreamerDevice / InfraredRemoteSetTopBoxDevice
 func (device *InfraredRemoteTVDevice) ChannelAdd() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "channelAdd")
+	return device.ChannelAddContext(context.Background())
+}
+
+// ChannelAddContext is the same as ChannelAdd, but uses the given context for the request
+func (device *InfraredRemoteTVDevice) ChannelAddContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "channelAdd")
 }
 
 // ChannelSub sends a command to decrease the channel of the InfraredRemoteTVDevice / InfraredRemoteStreamerDevice / InfraredRemoteSetTopBoxDevice
 func (device *InfraredRemoteTVDevice) ChannelSub() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "channelSub")
+	return device.ChannelSubContext(context.Background())
+}
+
+// ChannelSubContext is the same as ChannelSub, but uses the given context for the request
+func (device *InfraredRemoteTVDevice) ChannelSubContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "channelSub")
 }
 
 // SetMute sends a command to mute/unmute the InfraredRemoteDvdPlayerDevice / InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteDvdPlayerDevice) SetMute() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "setMute")
+	return device.SetMuteContext(context.Background())
+}
+
+// SetMuteContext is the same as SetMute, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) SetMuteContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "setMute")
 }
 
 // FastForward sends a command to fast-forward the InfraredRemoteDvdPlayerDevice / InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteDvdPlayerDevice) FastForward() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "fastForward")
+	return device.FastForwardContext(context.Background())
+}
+
+// FastForwardContext is the same as FastForward, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) FastForwardContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "fastForward")
 }
 
 // Rewind sends a command to rewind the InfraredRemoteDvdPlayerDevice / InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteDvdPlayerDevice) Rewind() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "Rewind")
+	return device.RewindContext(context.Background())
+}
+
+// RewindContext is the same as Rewind, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) RewindContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "Rewind")
 }
 
 // Next sends a command to play the next track on the InfraredRemoteDvdPlayerDevice / InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteDvdPlayerDevice) Next() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "Next")
+	return device.NextContext(context.Background())
+}
+
+// NextContext is the same as Next, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) NextContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "Next")
 }
 
 // Previous sends a command to play the previous track on the InfraredRemoteDvdPlayerDevice / InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteDvdPlayerDevice) Previous() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "Previous")
+	return device.PreviousContext(context.Background())
+}
+
+// PreviousContext is the same as Previous, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) PreviousContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "Previous")
 }
 
 // Pause sends a command to pause the InfraredRemoteDvdPlayerDevice / InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteDvdPlayerDevice) Pause() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "Pause")
+	return device.PauseContext(context.Background())
+}
+
+// PauseContext is the same as Pause, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) PauseContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "Pause")
 }
 
 // Play sends a command to play/resume the InfraredRemoteDvdPlayerDevice / InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteDvdPlayerDevice) Play() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "Play")
+	return device.PlayContext(context.Background())
+}
+
+// PlayContext is the same as Play, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) PlayContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "Play")
 }
 
 // Stop sends a command to stop the InfraredRemoteDvdPlayerDevice / InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteDvdPlayerDevice) Stop() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "Stop")
+	return device.StopContext(context.Background())
+}
+
+// StopContext is the same as Stop, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) StopContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "Stop")
 }
 
 // VolumeAdd sends a command to increase the volume of the InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteSpeakerDevice) VolumeAdd() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "volumeAdd")
+	return device.VolumeAddContext(context.Background())
+}
+
+// VolumeAddContext is the same as VolumeAdd, but uses the given context for the request
+func (device *InfraredRemoteSpeakerDevice) VolumeAddContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "volumeAdd")
 }
 
 // VolumeSub sends a command to decrease the volume of the InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteSpeakerDevice) VolumeSub() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "volumeSub")
+	return device.VolumeSubContext(context.Background())
+}
+
+// VolumeSubContext is the same as VolumeSub, but uses the given context for the request
+func (device *InfraredRemoteSpeakerDevice) VolumeSubContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "volumeSub")
 }
 
 // Swing sends a command to enable/disable the swing feature of the InfraredRemoteFanDevice
 func (device *InfraredRemoteFanDevice) Swing() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "swing")
+	return device.SwingContext(context.Background())
+}
+
+// SwingContext is the same as Swing, but uses the given context for the request
+func (device *InfraredRemoteFanDevice) SwingContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "swing")
 }
 
 // Timer sends a command to set the timer of the InfraredRemoteFanDevice
 func (device *InfraredRemoteFanDevice) Timer() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "timer")
+	return device.TimerContext(context.Background())
+}
+
+// TimerContext is the same as Timer, but uses the given context for the request
+func (device *InfraredRemoteFanDevice) TimerContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "timer")
 }
 
 // LowSpeed sends a command to set the fan speed to low on the InfraredRemoteFanDevice
 func (device *InfraredRemoteFanDevice) LowSpeed() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "lowSpeed")
+	return device.LowSpeedContext(context.Background())
+}
+
+// LowSpeedContext is the same as LowSpeed, but uses the given context for the request
+func (device *InfraredRemoteFanDevice) LowSpeedContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "lowSpeed")
 }
 
 // MiddleSpeed sends a command to set the fan speed to middle on the InfraredRemoteFanDevice
 func (device *InfraredRemoteFanDevice) MiddleSpeed() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "middleSpeed")
+	return device.MiddleSpeedContext(context.Background())
+}
+
+// MiddleSpeedContext is the same as MiddleSpeed, but uses the given context for the request
+func (device *InfraredRemoteFanDevice) MiddleSpeedContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "middleSpeed")
 }
 
 // HighSpeed sends a command to set the fan speed to high on the InfraredRemoteFanDevice
 func (device *InfraredRemoteFanDevice) HighSpeed() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "highSpeed")
+	return device.HighSpeedContext(context.Background())
+}
+
+// HighSpeedContext is the same as HighSpeed, but uses the given context for the request
+func (device *InfraredRemoteFanDevice) HighSpeedContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "highSpeed")
 }
 
 // BrightnessUp sends a command to increase the brightness of the InfraredRemoteLightDevice
 func (device *InfraredRemoteLightDevice) BrightnessUp() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "brightnessUp")
+	return device.BrightnessUpContext(context.Background())
+}
+
+// BrightnessUpContext is the same as BrightnessUp, but uses the given context for the request
+func (device *InfraredRemoteLightDevice) BrightnessUpContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "brightnessUp")
 }
 
 // BrightnessDown sends a command to decrease the brightness of the InfraredRemoteLightDevice
 func (device *InfraredRemoteLightDevice) BrightnessDown() (*CommonResponse, error) {
-	return sendDefaultParameterCommand(device.Client, device.DeviceID, "brightnessDown")
+	return device.BrightnessDownContext(context.Background())
+}
+
+// BrightnessDownContext is the same as BrightnessDown, but uses the given context for the request
+func (device *InfraredRemoteLightDevice) BrightnessDownContext(ctx context.Context) (*CommonResponse, error) {
+	return sendDefaultParameterCommand(ctx, device.Client, device.DeviceID, "brightnessDown")
 }
 
 // CustomCommand sends a user-defined command to the InfraredRemoteOthersDevice
 func (device *InfraredRemoteOthersDevice) CustomCommand(buttonName string) (*CommonResponse, error) {
+	return device.CustomCommandContext(context.Background(), buttonName)
+}
+
+// CustomCommandContext is the same as CustomCommand, but uses the given context for the request
+func (device *InfraredRemoteOthersDevice) CustomCommandContext(ctx context.Context, buttonName string) (*CommonResponse, error) {
 	request := ControlRequest{
 		CommandType: "command",
 		Command:     "customize",
 		Parameter:   buttonName,
 	}
-	return device.Client.SendCommand(device.DeviceID, request)
+	return device.Client.SendCommandContext(ctx, device.DeviceID, request)
 }
 
 func (client *Client) SendCommand(deviceId string, request ControlRequest) (*CommonResponse, error) {
-	return client.PostRequest("/devices/"+deviceId+"/commands", request)
+	return client.SendCommandContext(context.Background(), deviceId, request)
+}
+
+// SendCommandContext is the same as SendCommand, but uses the given context for the request
+func (client *Client) SendCommandContext(ctx context.Context, deviceId string, request ControlRequest) (*CommonResponse, error) {
+	return client.PostRequestContext(ctx, "/devices/"+deviceId+"/commands", request)
 }
@@ -1,6 +1,7 @@
 package switchbot
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"image/color"
@@ -64,6 +65,11 @@ type BotDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the BotDevice
 func (device *BotDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *BotDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter BotDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -71,11 +77,11 @@ func (device *BotDevice) ExecCommand(jsonString string) (*CommonResponse, error)
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Press":
-		return device.Press()
+		return device.PressContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -111,6 +117,11 @@ func (parameter *CurtainDeviceCommandParameter) JSONSchemaThen() interface{} {
 
 // ExecCommand sends a command to the CurtainDevice
 func (device *CurtainDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *CurtainDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter CurtainDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -118,13 +129,13 @@ func (device *CurtainDevice) ExecCommand(jsonString string) (*CommonResponse, er
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Pause":
-		return device.Pause()
+		return device.PauseContext(ctx)
 	case "SetPosition":
-		return device.SetPosition(CurtainPositionMode(parameter.Mode), parameter.Position)
+		return device.SetPositionContext(ctx, CurtainPositionMode(parameter.Mode), parameter.Position)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -143,6 +154,11 @@ type LockDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the LockDevice
 func (device *LockDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *LockDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter LockDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -150,9 +166,9 @@ func (device *LockDevice) ExecCommand(jsonString string) (*CommonResponse, error
 
 	switch parameter.Command {
 	case "Lock":
-		return device.Lock()
+		return device.LockContext(ctx)
 	case "Unlock":
-		return device.Unlock()
+		return device.UnlockContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -171,6 +187,11 @@ type LockLiteDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the LockLiteDevice
 func (device *LockLiteDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *LockLiteDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter LockLiteDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -178,9 +199,9 @@ func (device *LockLiteDevice) ExecCommand(jsonString string) (*CommonResponse, e
 
 	switch parameter.Command {
 	case "Lock":
-		return device.Lock()
+		return device.LockContext(ctx)
 	case "Unlock":
-		return device.Unlock()
+		return device.UnlockContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -271,6 +292,11 @@ func (parameter *KeypadDeviceCommandParameter) JSONSchemaAllOf() []interface{} {
 
 // ExecCommand sends a command to the KeypadDevice
 func (device *KeypadDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *KeypadDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter KeypadDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -282,9 +308,9 @@ func (device *KeypadDevice) ExecCommand(jsonString string) (*CommonResponse, err
 		if err != nil {
 			return nil, err
 		}
-		return device.CreateKey(key)
+		return device.CreateKeyContext(ctx, key)
 	case "DeleteKey":
-		return device.DeleteKey(parameter.Id)
+		return device.DeleteKeyContext(ctx, parameter.Id)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -347,6 +373,11 @@ func (parameter *CeilingLightDeviceCommandParameter) JSONSchemaAllOf() []interfa
 
 // ExecCommand sends a command to the CeilingLightDevice
 func (device *CeilingLightDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *CeilingLightDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter CeilingLightDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -354,15 +385,15 @@ func (device *CeilingLightDevice) ExecCommand(jsonString string) (*CommonRespons
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Toggle":
-		return device.Toggle()
+		return device.ToggleContext(ctx)
 	case "SetBrightness":
-		return device.SetBrightness(parameter.Brightness)
+		return device.SetBrightnessContext(ctx, parameter.Brightness)
 	case "SetColorTemperature":
-		return device.SetColorTemperature(parameter.ColorTemperature)
+		return device.SetColorTemperatureContext(ctx, parameter.ColorTemperature)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -381,6 +412,11 @@ type PlugMiniDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the PlugMiniDevice
 func (device *PlugMiniDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *PlugMiniDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter PlugMiniDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -388,11 +424,11 @@ func (device *PlugMiniDevice) ExecCommand(jsonString string) (*CommonResponse, e
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Toggle":
-		return device.Toggle()
+		return device.ToggleContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -411,6 +447,11 @@ type PlugDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the PlugDevice
 func (device *PlugDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *PlugDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter PlugDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -418,9 +459,9 @@ func (device *PlugDevice) ExecCommand(jsonString string) (*CommonResponse, error
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -487,6 +528,11 @@ func (parameter *StripLightDeviceCommandParameter) JSONSchemaAllOf() []interface
 
 // ExecCommand sends a command to the StripLightDevice
 func (device *StripLightDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *StripLightDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter StripLightDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -494,15 +540,15 @@ func (device *StripLightDevice) ExecCommand(jsonString string) (*CommonResponse,
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Toggle":
-		return device.Toggle()
+		return device.ToggleContext(ctx)
 	case "SetBrightness":
-		return device.SetBrightness(parameter.Brightness)
+		return device.SetBrightnessContext(ctx, parameter.Brightness)
 	case "SetColor":
-		return device.SetColor(color.RGBA{R: uint8(parameter.Red), G: uint8(parameter.Green), B: uint8(parameter.Blue), A: 255})
+		return device.SetColorContext(ctx, color.RGBA{R: uint8(parameter.Red), G: uint8(parameter.Green), B: uint8(parameter.Blue), A: 255})
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -588,6 +634,11 @@ func (parameter *ColorLightDeviceCommandParameter) JSONSchemaAllOf() []interface
 
 // ExecCommand sends a command to the ColorLightDevice
 func (device *ColorLightDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *ColorLightDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter ColorLightDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -595,17 +646,17 @@ func (device *ColorLightDevice) ExecCommand(jsonString string) (*CommonResponse,
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Toggle":
-		return device.Toggle()
+		return device.ToggleContext(ctx)
 	case "SetBrightness":
-		return device.SetBrightness(parameter.Brightness)
+		return device.SetBrightnessContext(ctx, parameter.Brightness)
 	case "SetColor":
-		return device.SetColor(color.RGBA{R: uint8(parameter.Red), G: uint8(parameter.Green), B: uint8(parameter.Blue), A: 255})
+		return device.SetColorContext(ctx, color.RGBA{R: uint8(parameter.Red), G: uint8(parameter.Green), B: uint8(parameter.Blue), A: 255})
 	case "SetColorTemperature":
-		return device.SetColorTemperature(parameter.ColorTemperature)
+		return device.SetColorTemperatureContext(ctx, parameter.ColorTemperature)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -649,6 +700,11 @@ func (parameter *RobotVacuumCleanerDeviceCommandParameter) JSONSchemaAllOf() []i
 
 // ExecCommand sends a command to the RobotVacuumCleanerDevice
 func (device *RobotVacuumCleanerDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *RobotVacuumCleanerDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter RobotVacuumCleanerDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -656,13 +712,13 @@ func (device *RobotVacuumCleanerDevice) ExecCommand(jsonString string) (*CommonR
 
 	switch parameter.Command {
 	case "Start":
-		return device.Start()
+		return device.StartContext(ctx)
 	case "Stop":
-		return device.Stop()
+		return device.StopContext(ctx)
 	case "Dock":
-		return device.Dock()
+		return device.DockContext(ctx)
 	case "SetPowerLevel":
-		return device.SetPowerLevel(RobotVacuumCleanerPowerLevel(parameter.PowerLevel))
+		return device.SetPowerLevelContext(ctx, RobotVacuumCleanerPowerLevel(parameter.PowerLevel))
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -770,6 +826,11 @@ func (parameter *RobotVacuumCleanerSDeviceCommandParameter) JSONSchemaAllOf() []
 
 // ExecCommand sends a command to the RobotVacuumCleanerSDevice
 func (device *RobotVacuumCleanerSDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter RobotVacuumCleanerSDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -781,23 +842,23 @@ func (device *RobotVacuumCleanerSDevice) ExecCommand(jsonString string) (*Common
 		if err != nil {
 			return nil, err
 		}
-		return device.StartClean(startParam)
+		return device.StartCleanContext(ctx, startParam)
 	case "AddWaterForHumi":
-		return device.AddWaterForHumi()
+		return device.AddWaterForHumiContext(ctx)
 	case "Pause":
-		return device.Pause()
+		return device.PauseContext(ctx)
 	case "Dock":
-		return device.Dock()
+		return device.DockContext(ctx)
 	case "SetVolume":
-		return device.SetVolume(parameter.Volume)
+		return device.SetVolumeContext(ctx, parameter.Volume)
 	case "SelfClean":
-		return device.SelfClean(SelfCleaningMode(parameter.Mode))
+		return device.SelfCleanContext(ctx, SelfCleaningMode(parameter.Mode))
 	case "ChangeParam":
 		floorParam, err := NewFloorCleaningParam(parameter.FanLevel, parameter.WaterLevel, parameter.Times)
 		if err != nil {
 			return nil, err
 		}
-		return device.ChangeParam(floorParam)
+		return device.ChangeParamContext(ctx, floorParam)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -886,6 +947,11 @@ func (parameter *RobotVacuumCleanerComboDeviceCommandParameter) JSONSchemaAllOf(
 
 // ExecCommand sends a command to the RobotVacuumCleanerComboDevice
 func (device *RobotVacuumCleanerComboDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *RobotVacuumCleanerComboDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter RobotVacuumCleanerComboDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -898,19 +964,19 @@ func (device *RobotVacuumCleanerComboDevice) ExecCommand(jsonString string) (*Co
 		if err != nil {
 			return nil, err
 		}
-		return device.StartClean(startParam)
+		return device.StartCleanContext(ctx, startParam)
 	case "pause":
-		return device.Pause()
+		return device.PauseContext(ctx)
 	case "dock":
-		return device.Dock()
+		return device.DockContext(ctx)
 	case "setVolume":
-		return device.SetVolume(parameter.Volume)
+		return device.SetVolumeContext(ctx, parameter.Volume)
 	case "changeParam":
 		floorParam, err := NewFloorCleaningParam(parameter.FanLevel, parameter.WaterLevel, parameter.Times)
 		if err != nil {
 			return nil, err
 		}
-		return device.ChangeParam(floorParam)
+		return device.ChangeParamContext(ctx, floorParam)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -989,6 +1055,11 @@ func parseHumidifierMode(s string) (HumidifierMode, error) {
 
 // ExecCommand sends a command to the HumidifierDevice
 func (device *HumidifierDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *HumidifierDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter HumidifierDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -996,17 +1067,17 @@ func (device *HumidifierDevice) ExecCommand(jsonString string) (*CommonResponse,
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "SetMode":
 		mode, err := parseHumidifierMode(parameter.Mode)
 		if err != nil {
 			return nil, err
 		}
-		return device.SetMode(mode)
+		return device.SetModeContext(ctx, mode)
 	case "SetTargetHumidity":
-		return device.SetTargetHumidity(parameter.TargetHumidity)
+		return device.SetTargetHumidityContext(ctx, parameter.TargetHumidity)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1071,6 +1142,11 @@ func (parameter *EvaporativeHumidifierDeviceCommandParameter) JSONSchemaAllOf()
 
 // ExecCommand sends a command to the EvaporativeHumidifierDevice
 func (device *EvaporativeHumidifierDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *EvaporativeHumidifierDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter EvaporativeHumidifierDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1078,13 +1154,13 @@ func (device *EvaporativeHumidifierDevice) ExecCommand(jsonString string) (*Comm
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "SetMode":
-		return device.SetMode(EvaporativeHumidifierMode(parameter.Mode), parameter.TargetHumidity)
+		return device.SetModeContext(ctx, EvaporativeHumidifierMode(parameter.Mode), parameter.TargetHumidity)
 	case "SetChildLock":
-		return device.SetChildLock(parameter.ChildLock)
+		return device.SetChildLockContext(ctx, parameter.ChildLock)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1168,6 +1244,11 @@ func (parameter *AirPurifierDeviceCommandParameter) JSONSchemaAllOf() []interfac
 
 // ExecCommand sends a command to the AirPurifierDevice
 func (device *AirPurifierDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *AirPurifierDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter AirPurifierDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1175,13 +1256,13 @@ func (device *AirPurifierDevice) ExecCommand(jsonString string) (*CommonResponse
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "SetMode":
-		return device.SetMode(AirPurifierMode(parameter.Mode), parameter.FanLevel)
+		return device.SetModeContext(ctx, AirPurifierMode(parameter.Mode), parameter.FanLevel)
 	case "SetChildLock":
-		return device.SetChildLock(parameter.ChildLock)
+		return device.SetChildLockContext(ctx, parameter.ChildLock)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1227,6 +1308,11 @@ func (parameter *BlindTiltDeviceCommandParameter) JSONSchemaAllOf() []interface{
 
 // ExecCommand sends a command to the BlindTiltDevice
 func (device *BlindTiltDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *BlindTiltDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter BlindTiltDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1237,13 +1323,13 @@ func (device *BlindTiltDevice) ExecCommand(jsonString string) (*CommonResponse,
 		if parameter.Position%2 != 0 {
 			return nil, fmt.Errorf("position must be even: %d", parameter.Position)
 		}
-		return device.SetPosition(parameter.Direction, parameter.Position)
+		return device.SetPositionContext(ctx, parameter.Direction, parameter.Position)
 	case "FullyOpen":
-		return device.FullyOpen()
+		return device.FullyOpenContext(ctx)
 	case "CloseUp":
-		return device.CloseUp()
+		return device.CloseUpContext(ctx)
 	case "CloseDown":
-		return device.CloseDown()
+		return device.CloseDownContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1325,6 +1411,11 @@ func (parameter *CirculatorFanDeviceCommandParameter) JSONSchemaAllOf() []interf
 
 // ExecCommand sends a command to the BatteryCirculatorFanDevice
 func (device *BatteryCirculatorFanDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *BatteryCirculatorFanDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter CirculatorFanDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1332,15 +1423,15 @@ func (device *BatteryCirculatorFanDevice) ExecCommand(jsonString string) (*Commo
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "SetNightLightMode":
-		return device.SetNightLightMode(CirculatorNightLightMode(parameter.NightLight))
+		return device.SetNightLightModeContext(ctx, CirculatorNightLightMode(parameter.NightLight))
 	case "SetWindMode":
-		return device.SetWindMode(CirculatorWindMode(parameter.WindMode))
+		return device.SetWindModeContext(ctx, CirculatorWindMode(parameter.WindMode))
 	case "SetWindSpeed":
-		return device.SetWindSpeed(parameter.WindSpeed)
+		return device.SetWindSpeedContext(ctx, parameter.WindSpeed)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1353,6 +1444,11 @@ func (device *BatteryCirculatorFanDevice) GetCommandParameterJSONSchema() (strin
 
 // ExecCommand sends a command to the CirculatorFanDevice
 func (device *CirculatorFanDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *CirculatorFanDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter CirculatorFanDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1360,15 +1456,15 @@ func (device *CirculatorFanDevice) ExecCommand(jsonString string) (*CommonRespon
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "SetNightLightMode":
-		return device.SetNightLightMode(CirculatorNightLightMode(parameter.NightLight))
+		return device.SetNightLightModeContext(ctx, CirculatorNightLightMode(parameter.NightLight))
 	case "SetWindMode":
-		return device.SetWindMode(CirculatorWindMode(parameter.WindMode))
+		return device.SetWindModeContext(ctx, CirculatorWindMode(parameter.WindMode))
 	case "SetWindSpeed":
-		return device.SetWindSpeed(parameter.WindSpeed)
+		return device.SetWindSpeedContext(ctx, parameter.WindSpeed)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1388,6 +1484,11 @@ type RollerShadeDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the RollerShadeDevice
 func (device *RollerShadeDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *RollerShadeDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter RollerShadeDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1395,7 +1496,7 @@ func (device *RollerShadeDevice) ExecCommand(jsonString string) (*CommonResponse
 
 	switch parameter.Command {
 	case "SetPosition":
-		return device.SetPosition(parameter.Position)
+		return device.SetPositionContext(ctx, parameter.Position)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1439,6 +1540,11 @@ func (parameter *RelaySwitch1DeviceCommandParameter) JSONSchemaAllOf() []interfa
 
 // ExecCommand sends a command to the RelaySwitch1Device
 func (device *RelaySwitch1Device) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *RelaySwitch1Device) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter RelaySwitch1DeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1446,13 +1552,13 @@ func (device *RelaySwitch1Device) ExecCommand(jsonString string) (*CommonRespons
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Toggle":
-		return device.Toggle()
+		return device.ToggleContext(ctx)
 	case "SetMode":
-		return device.SetMode(RelaySwitchMode(parameter.Mode))
+		return device.SetModeContext(ctx, RelaySwitchMode(parameter.Mode))
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1465,6 +1571,11 @@ func (device *RelaySwitch1Device) GetCommandParameterJSONSchema() (string, error
 
 // ExecCommand sends a command to the RelaySwitch1PMDevice
 func (device *RelaySwitch1PMDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *RelaySwitch1PMDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter RelaySwitch1DeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1472,13 +1583,13 @@ func (device *RelaySwitch1PMDevice) ExecCommand(jsonString string) (*CommonRespo
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Toggle":
-		return device.Toggle()
+		return device.ToggleContext(ctx)
 	case "SetMode":
-		return device.SetMode(RelaySwitchMode(parameter.Mode))
+		return device.SetModeContext(ctx, RelaySwitchMode(parameter.Mode))
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1523,6 +1634,11 @@ func (parameter *RelaySwitch2PMDeviceCommandParameter) JSONSchemaAllOf() []inter
 
 // ExecCommand sends a command to the RelaySwitch2PMDevice
 func (device *RelaySwitch2PMDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *RelaySwitch2PMDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter RelaySwitch2PMDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1530,13 +1646,13 @@ func (device *RelaySwitch2PMDevice) ExecCommand(jsonString string) (*CommonRespo
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn(parameter.Switch)
+		return device.TurnOnContext(ctx, parameter.Switch)
 	case "TurnOff":
-		return device.TurnOff(parameter.Switch)
+		return device.TurnOffContext(ctx, parameter.Switch)
 	case "Toggle":
-		return device.Toggle(parameter.Switch)
+		return device.ToggleContext(ctx, parameter.Switch)
 	case "SetMode":
-		return device.SetMode(parameter.Switch, RelaySwitchMode(parameter.Mode))
+		return device.SetModeContext(ctx, parameter.Switch, RelaySwitchMode(parameter.Mode))
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1555,6 +1671,11 @@ type GarageDoorOpenerDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the GarageDoorOpenerDevice
 func (device *GarageDoorOpenerDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *GarageDoorOpenerDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter GarageDoorOpenerDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1562,9 +1683,9 @@ func (device *GarageDoorOpenerDevice) ExecCommand(jsonString string) (*CommonRes
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1583,6 +1704,11 @@ type VideoDoorbellDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the VideoDoorbellDevice
 func (device *VideoDoorbellDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *VideoDoorbellDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter VideoDoorbellDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1590,9 +1716,9 @@ func (device *VideoDoorbellDevice) ExecCommand(jsonString string) (*CommonRespon
 
 	switch parameter.Command {
 	case "EnableMotionDetection":
-		return device.EnableMotionDetection()
+		return device.EnableMotionDetectionContext(ctx)
 	case "DisableMotionDetection":
-		return device.DisableMotionDetection()
+		return device.DisableMotionDetectionContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1642,6 +1768,11 @@ func (parameter *InfraredRemoteAirConditionerDeviceCommandParameter) JSONSchemaA
 
 // ExecCommand sends a command to the InfraredRemoteAirConditionerDevice
 func (device *InfraredRemoteAirConditionerDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *InfraredRemoteAirConditionerDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter InfraredRemoteAirConditionerDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1649,11 +1780,11 @@ func (device *InfraredRemoteAirConditionerDevice) ExecCommand(jsonString string)
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "SetAll":
-		return device.SetAll(
+		return device.SetAllContext(ctx,
 			parameter.TemperatureCelsius,
 			AirConditionerMode(parameter.Mode),
 			AirConditionerFanMode(parameter.Fan),
@@ -1702,6 +1833,11 @@ func (parameter *InfraredRemoteTVDeviceCommandParameter) JSONSchemaAllOf() []int
 
 // ExecCommand sends a command to the InfraredRemoteTVDevice
 func (device *InfraredRemoteTVDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *InfraredRemoteTVDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter InfraredRemoteTVDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1709,19 +1845,19 @@ func (device *InfraredRemoteTVDevice) ExecCommand(jsonString string) (*CommonRes
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "VolumeAdd":
-		return device.VolumeAdd()
+		return device.VolumeAddContext(ctx)
 	case "VolumeSub":
-		return device.VolumeSub()
+		return device.VolumeSubContext(ctx)
 	case "ChannelAdd":
-		return device.ChannelAdd()
+		return device.ChannelAddContext(ctx)
 	case "ChannelSub":
-		return device.ChannelSub()
+		return device.ChannelSubContext(ctx)
 	case "SetChannel":
-		return device.SetChannel(parameter.Channel)
+		return device.SetChannelContext(ctx, parameter.Channel)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1740,6 +1876,11 @@ type InfraredRemoteDvdPlayerDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the InfraredRemoteDvdPlayerDevice
 func (device *InfraredRemoteDvdPlayerDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *InfraredRemoteDvdPlayerDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter InfraredRemoteDvdPlayerDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1747,25 +1888,25 @@ func (device *InfraredRemoteDvdPlayerDevice) ExecCommand(jsonString string) (*Co
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "SetMute":
-		return device.SetMute()
+		return device.SetMuteContext(ctx)
 	case "FastForward":
-		return device.FastForward()
+		return device.FastForwardContext(ctx)
 	case "Rewind":
-		return device.Rewind()
+		return device.RewindContext(ctx)
 	case "Next":
-		return device.Next()
+		return device.NextContext(ctx)
 	case "Previous":
-		return device.Previous()
+		return device.PreviousContext(ctx)
 	case "Pause":
-		return device.Pause()
+		return device.PauseContext(ctx)
 	case "Play":
-		return device.Play()
+		return device.PlayContext(ctx)
 	case "Stop":
-		return device.Stop()
+		return device.StopContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1784,6 +1925,11 @@ type InfraredRemoteSpeakerDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the InfraredRemoteSpeakerDevice
 func (device *InfraredRemoteSpeakerDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *InfraredRemoteSpeakerDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter InfraredRemoteSpeakerDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1791,29 +1937,29 @@ func (device *InfraredRemoteSpeakerDevice) ExecCommand(jsonString string) (*Comm
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "VolumeAdd":
-		return device.VolumeAdd()
+		return device.VolumeAddContext(ctx)
 	case "VolumeSub":
-		return device.VolumeSub()
+		return device.VolumeSubContext(ctx)
 	case "SetMute":
-		return device.SetMute()
+		return device.SetMuteContext(ctx)
 	case "FastForward":
-		return device.FastForward()
+		return device.FastForwardContext(ctx)
 	case "Rewind":
-		return device.Rewind()
+		return device.RewindContext(ctx)
 	case "Next":
-		return device.Next()
+		return device.NextContext(ctx)
 	case "Previous":
-		return device.Previous()
+		return device.PreviousContext(ctx)
 	case "Pause":
-		return device.Pause()
+		return device.PauseContext(ctx)
 	case "Play":
-		return device.Play()
+		return device.PlayContext(ctx)
 	case "Stop":
-		return device.Stop()
+		return device.StopContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1832,6 +1978,11 @@ type InfraredRemoteFanDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the InfraredRemoteFanDevice
 func (device *InfraredRemoteFanDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *InfraredRemoteFanDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter InfraredRemoteFanDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1839,19 +1990,19 @@ func (device *InfraredRemoteFanDevice) ExecCommand(jsonString string) (*CommonRe
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "Swing":
-		return device.Swing()
+		return device.SwingContext(ctx)
 	case "Timer":
-		return device.Timer()
+		return device.TimerContext(ctx)
 	case "LowSpeed":
-		return device.LowSpeed()
+		return device.LowSpeedContext(ctx)
 	case "MiddleSpeed":
-		return device.MiddleSpeed()
+		return device.MiddleSpeedContext(ctx)
 	case "HighSpeed":
-		return device.HighSpeed()
+		return device.HighSpeedContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
@@ -1870,6 +2021,11 @@ type InfraredRemoteLightDeviceCommandParameter struct {
 
 // ExecCommand sends a command to the InfraredRemoteLightDevice
 func (device *InfraredRemoteLightDevice) ExecCommand(jsonString string) (*CommonResponse, error) {
+	return device.ExecCommandContext(context.Background(), jsonString)
+}
+
+// ExecCommandContext is the same as ExecCommand, but uses the given context for the request
+func (device *InfraredRemoteLightDevice) ExecCommandContext(ctx context.Context, jsonString string) (*CommonResponse, error) {
 	var parameter InfraredRemoteLightDeviceCommandParameter
 	if err := validateAndUnmarshalJSON(device, jsonString, &parameter); err != nil {
 		return nil, err
@@ -1877,13 +2033,13 @@ func (device *InfraredRemoteLightDevice) ExecCommand(jsonString string) (*Common
 
 	switch parameter.Command {
 	case "TurnOn":
-		return device.TurnOn()
+		return device.TurnOnContext(ctx)
 	case "TurnOff":
-		return device.TurnOff()
+		return device.TurnOffContext(ctx)
 	case "BrightnessUp":
-		return device.BrightnessUp()
+		return device.BrightnessUpContext(ctx)
 	case "BrightnessDown":
-		return device.BrightnessDown()
+		return device.BrightnessDownContext(ctx)
 	default:
 		return nil, fmt.Errorf("invalid Command: %s", parameter.Command)
 	}
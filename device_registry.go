@@ -0,0 +1,188 @@
+package switchbot
+
+import (
+	"slices"
+	"sync"
+)
+
+// DeviceFactory creates an empty device struct with the Client set to the given API.
+// The returned value must be a pointer so that the device list item can be unmarshalled into it.
+type DeviceFactory func(client API) any
+
+var (
+	deviceRegistryMu        sync.RWMutex
+	deviceFactories         = map[string]DeviceFactory{}
+	infraredRemoteFactories = map[string]DeviceFactory{}
+)
+
+// RegisterDeviceType registers the factory used by GetDevices for the given deviceType of a physical device.
+// Registering a deviceType that is already registered replaces the factory, which allows overriding the built-in types.
+func RegisterDeviceType(deviceType string, factory DeviceFactory) {
+	if factory == nil {
+		panic("switchbot: RegisterDeviceType factory is nil for " + deviceType)
+	}
+	deviceRegistryMu.Lock()
+	defer deviceRegistryMu.Unlock()
+	deviceFactories[deviceType] = factory
+}
+
+// RegisterInfraredRemoteType registers the factory used by GetDevices for the given remoteType of an infrared remote device.
+// Registering a remoteType that is already registered replaces the factory, which allows overriding the built-in types.
+func RegisterInfraredRemoteType(remoteType string, factory DeviceFactory) {
+	if factory == nil {
+		panic("switchbot: RegisterInfraredRemoteType factory is nil for " + remoteType)
+	}
+	deviceRegistryMu.Lock()
+	defer deviceRegistryMu.Unlock()
+	infraredRemoteFactories[remoteType] = factory
+}
+
+// unregisterDeviceType removes the factory of the deviceType. It is used by tests to clean up the types they register.
+func unregisterDeviceType(deviceType string) {
+	deviceRegistryMu.Lock()
+	defer deviceRegistryMu.Unlock()
+	delete(deviceFactories, deviceType)
+}
+
+// unregisterInfraredRemoteType removes the factory of the remoteType. It is used by tests to clean up the types they register.
+func unregisterInfraredRemoteType(remoteType string) {
+	deviceRegistryMu.Lock()
+	defer deviceRegistryMu.Unlock()
+	delete(infraredRemoteFactories, remoteType)
+}
+
+// DeviceTypes returns the registered deviceTypes of physical devices in sorted order
+func DeviceTypes() []string {
+	deviceRegistryMu.RLock()
+	defer deviceRegistryMu.RUnlock()
+	return sortedKeys(deviceFactories)
+}
+
+// InfraredRemoteTypes returns the registered remoteTypes of infrared remote devices in sorted order
+func InfraredRemoteTypes() []string {
+	deviceRegistryMu.RLock()
+	defer deviceRegistryMu.RUnlock()
+	return sortedKeys(infraredRemoteFactories)
+}
+
+// NewDevice creates an empty physical device struct for the given deviceType.
+// It returns a *CommonDeviceListItem if the deviceType is not registered.
+func NewDevice(deviceType string, client API) any {
+	deviceRegistryMu.RLock()
+	factory, ok := deviceFactories[deviceType]
+	deviceRegistryMu.RUnlock()
+	if !ok {
+		return &CommonDeviceListItem{Client: client}
+	}
+	return factory(client)
+}
+
+// NewInfraredRemoteDevice creates an empty infrared remote device struct for the given remoteType.
+// It returns a *InfraredRemoteDevice if the remoteType is not registered.
+func NewInfraredRemoteDevice(remoteType string, client API) any {
+	deviceRegistryMu.RLock()
+	factory, ok := infraredRemoteFactories[remoteType]
+	deviceRegistryMu.RUnlock()
+	if !ok {
+		return &InfraredRemoteDevice{Client: client}
+	}
+	return factory(client)
+}
+
+func sortedKeys(factories map[string]DeviceFactory) []string {
+	keys := make([]string, 0, len(factories))
+	for key := range factories {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// clientSetter is implemented by the device structs through the embedded CommonDeviceListItem or InfraredRemoteDevice
+type clientSetter interface {
+	setClient(client API)
+}
+
+func (device *CommonDeviceListItem) setClient(client API) {
+	device.Client = client
+}
+
+func (device *InfraredRemoteDevice) setClient(client API) {
+	device.Client = client
+}
+
+func (device *InfraredRemoteOthersDevice) setClient(client API) {
+	device.Client = client
+}
+
+// deviceFactory returns a DeviceFactory that creates a new T with the Client set
+func deviceFactory[T any, PT interface {
+	*T
+	clientSetter
+}]() DeviceFactory {
+	return func(client API) any {
+		device := PT(new(T))
+		device.setClient(client)
+		return device
+	}
+}
+
+func registerDeviceTypes(factory DeviceFactory, deviceTypes ...string) {
+	for _, deviceType := range deviceTypes {
+		RegisterDeviceType(deviceType, factory)
+	}
+}
+
+func init() {
+	registerDeviceTypes(deviceFactory[BotDevice](), "Bot")
+	registerDeviceTypes(deviceFactory[CurtainDevice](), "Curtain", "Curtain3")
+	registerDeviceTypes(deviceFactory[HubDevice](), "Hub", "Hub Plus", "Hub Mini")
+	registerDeviceTypes(deviceFactory[Hub2Device](), "Hub 2")
+	registerDeviceTypes(deviceFactory[Hub3Device](), "Hub 3")
+	registerDeviceTypes(deviceFactory[MeterDevice](), "Meter", "MeterPlus", "WoIOSensor", "MeterPro")
+	registerDeviceTypes(deviceFactory[MeterProCo2Device](), "MeterPro(CO2)")
+	registerDeviceTypes(deviceFactory[LockDevice](), "Smart Lock", "Smart Lock Pro", "Smart Lock Ultra")
+	registerDeviceTypes(deviceFactory[LockLiteDevice](), "Smart Lock Lite")
+	registerDeviceTypes(deviceFactory[KeypadDevice](), "Keypad", "Keypad Touch", "Keypad Vision")
+	registerDeviceTypes(deviceFactory[RemoteDevice](), "Remote")
+	registerDeviceTypes(deviceFactory[MotionSensorDevice](), "Motion Sensor")
+	registerDeviceTypes(deviceFactory[ContactSensorDevice](), "Contact Sensor")
+	registerDeviceTypes(deviceFactory[WaterLeakDetectorDevice](), "Water Detector")
+	registerDeviceTypes(deviceFactory[CeilingLightDevice](), "Ceiling Light", "Ceiling Light Pro")
+	registerDeviceTypes(deviceFactory[PlugMiniDevice](), "Plug Mini (US)", "Plug Mini (JP)")
+	registerDeviceTypes(deviceFactory[PlugDevice](), "Plug")
+	registerDeviceTypes(deviceFactory[StripLightDevice](), "Strip Light")
+	registerDeviceTypes(deviceFactory[ColorLightDevice](), "Color Bulb", "Floor Lamp", "Strip Light 3")
+	registerDeviceTypes(deviceFactory[RobotVacuumCleanerDevice](), "Robot Vacuum Cleaner S1", "Robot Vacuum Cleaner S1 Plus", "K10+", "K10+ Pro")
+	registerDeviceTypes(deviceFactory[RobotVacuumCleanerComboDevice](), "Robot Vacuum Cleaner K10+ Pro Combo", "Robot Vacuum Cleaner K20 Plus Pro")
+	registerDeviceTypes(deviceFactory[RobotVacuumCleanerSDevice](), "Robot Vacuum Cleaner S10", "Robot Vacuum Cleaner S20")
+	registerDeviceTypes(deviceFactory[HumidifierDevice](), "Humidifier")
+	registerDeviceTypes(deviceFactory[EvaporativeHumidifierDevice](), "Humidifier2")
+	registerDeviceTypes(deviceFactory[AirPurifierDevice](), "Air Purifier VOC", "Air Purifier Table VOC", "Air Purifier PM2.5", "Air Purifier Table PM2.5")
+	registerDeviceTypes(deviceFactory[IndoorCamDevice](), "Indoor Cam")
+	registerDeviceTypes(deviceFactory[PanTiltCamDevice](), "Pan/Tilt Cam")
+	registerDeviceTypes(deviceFactory[BlindTiltDevice](), "Blind Tilt")
+	registerDeviceTypes(deviceFactory[BatteryCirculatorFanDevice](), "Battery Circulator Fan")
+	registerDeviceTypes(deviceFactory[CirculatorFanDevice](), "Circulator Fan")
+	registerDeviceTypes(deviceFactory[RollerShadeDevice](), "Roller Shade")
+	registerDeviceTypes(deviceFactory[RelaySwitch1PMDevice](), "Relay Switch 1PM")
+	registerDeviceTypes(deviceFactory[RelaySwitch1Device](), "Relay Switch 1")
+	registerDeviceTypes(deviceFactory[RelaySwitch2PMDevice](), "Relay Switch 2PM")
+	registerDeviceTypes(deviceFactory[GarageDoorOpenerDevice](), "Garage Door Opener")
+	registerDeviceTypes(deviceFactory[VideoDoorbellDevice](), "Video Doorbell")
+
+	RegisterInfraredRemoteType("Air Conditioner", deviceFactory[InfraredRemoteAirConditionerDevice]())
+	RegisterInfraredRemoteType("TV", deviceFactory[InfraredRemoteTVDevice]())
+	RegisterInfraredRemoteType("Light", deviceFactory[InfraredRemoteLightDevice]())
+	RegisterInfraredRemoteType("Streamer", deviceFactory[InfraredRemoteStreamerDevice]())
+	RegisterInfraredRemoteType("Set Top Box", deviceFactory[InfraredRemoteSetTopBoxDevice]())
+	RegisterInfraredRemoteType("DVD Player", deviceFactory[InfraredRemoteDvdPlayerDevice]())
+	RegisterInfraredRemoteType("Fan", deviceFactory[InfraredRemoteFanDevice]())
+	RegisterInfraredRemoteType("Projector", deviceFactory[InfraredRemoteProjectorDevice]())
+	RegisterInfraredRemoteType("Camera", deviceFactory[InfraredRemoteCameraDevice]())
+	RegisterInfraredRemoteType("Air Purifier", deviceFactory[InfraredRemoteAirPurifierDevice]())
+	RegisterInfraredRemoteType("Speaker", deviceFactory[InfraredRemoteSpeakerDevice]())
+	RegisterInfraredRemoteType("Water Heater", deviceFactory[InfraredRemoteWaterHeaterDevice]())
+	RegisterInfraredRemoteType("Robot Vacuum Cleaner", deviceFactory[InfraredRemoteRobotVacuumCleanerDevice]())
+	RegisterInfraredRemoteType("Others", deviceFactory[InfraredRemoteOthersDevice]())
+}
@@ -0,0 +1,150 @@
+package switchbot_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+// deviceStructNames returns the names of the device structs declared in device.go
+func deviceStructNames(t *testing.T) []string {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "device.go", nil, 0)
+	assert.NoError(t, err)
+
+	var names []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		typeSpec, ok := node.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		if _, isStruct := typeSpec.Type.(*ast.StructType); isStruct && strings.HasSuffix(typeSpec.Name.Name, "Device") {
+			names = append(names, typeSpec.Name.Name)
+		}
+		return false
+	})
+	return names
+}
+
+func TestDeviceRegistryReachability(t *testing.T) {
+	client := switchbot.NewClient("secret", "token")
+
+	reachable := map[string]bool{
+		// CommonDevice is embedded in every physical device and is not a device itself
+		"CommonDevice": true,
+	}
+	for _, deviceType := range switchbot.DeviceTypes() {
+		device := switchbot.NewDevice(deviceType, client)
+		reachable[reflect.TypeOf(device).Elem().Name()] = true
+	}
+	for _, remoteType := range switchbot.InfraredRemoteTypes() {
+		device := switchbot.NewInfraredRemoteDevice(remoteType, client)
+		reachable[reflect.TypeOf(device).Elem().Name()] = true
+	}
+	reachable[reflect.TypeOf(switchbot.NewInfraredRemoteDevice("Unknown", client)).Elem().Name()] = true
+
+	for _, name := range deviceStructNames(t) {
+		assert.True(t, reachable[name], "%s is not produced by any registered type", name)
+	}
+}
+
+func TestDeviceRegistryClient(t *testing.T) {
+	client := switchbot.NewClient("secret", "token")
+
+	for _, deviceType := range switchbot.DeviceTypes() {
+		device := switchbot.NewDevice(deviceType, client)
+		assert.Same(t, client, reflect.ValueOf(device).Elem().FieldByName("Client").Interface(), deviceType)
+	}
+	for _, remoteType := range switchbot.InfraredRemoteTypes() {
+		device := switchbot.NewInfraredRemoteDevice(remoteType, client)
+		assert.Same(t, client, reflect.ValueOf(device).Elem().FieldByName("Client").Interface(), remoteType)
+	}
+}
+
+// FutureDevice is a device type that is not built in
+type FutureDevice struct {
+	switchbot.CommonDeviceListItem
+	NewField string `json:"newField"`
+}
+
+func TestRegisterDeviceType(t *testing.T) {
+	switchbot.RegisterDeviceType("Future Device", func(client switchbot.API) any {
+		return &FutureDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{Client: client}}
+	})
+	switchbot.RegisterInfraredRemoteType("Future Remote", func(client switchbot.API) any {
+		return &switchbot.InfraredRemoteTVDevice{InfraredRemoteDevice: switchbot.InfraredRemoteDevice{Client: client}}
+	})
+	t.Cleanup(func() {
+		switchbot.UnregisterDeviceType("Future Device")
+		switchbot.UnregisterInfraredRemoteType("Future Remote")
+	})
+	assert.Contains(t, switchbot.DeviceTypes(), "Future Device")
+	assert.Contains(t, switchbot.InfraredRemoteTypes(), "Future Remote")
+
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterDevicesMock(
+		[]interface{}{
+			map[string]interface{}{
+				"deviceId":   "ABCDEF123456",
+				"deviceType": "Future Device",
+				"deviceName": "FutureDevice",
+				"newField":   "value",
+			},
+			map[string]interface{}{
+				"deviceId":   "ABCDEF654321",
+				"deviceType": "Garage Door Opener",
+				"deviceName": "GarageDoorOpenerDevice",
+			},
+		},
+		[]interface{}{
+			map[string]interface{}{
+				"deviceId":    "02-202008110034-13",
+				"deviceName":  "FutureRemote",
+				"remoteType":  "Future Remote",
+				"hubDeviceId": "FA7310762361",
+			},
+		},
+	)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	response, err := client.GetDevices()
+	assert.NoError(t, err)
+
+	futureDevice, ok := response.Body.DeviceList[0].(*FutureDevice)
+	assert.True(t, ok)
+	assert.Equal(t, "value", futureDevice.NewField)
+	assert.Same(t, client, futureDevice.Client)
+
+	garageDoorOpener, ok := response.Body.DeviceList[1].(*switchbot.GarageDoorOpenerDevice)
+	assert.True(t, ok)
+	assert.Equal(t, "ABCDEF654321", garageDoorOpener.DeviceID)
+	assert.Same(t, client, garageDoorOpener.Client)
+
+	futureRemote, ok := response.Body.InfraredRemoteList[0].(*switchbot.InfraredRemoteTVDevice)
+	assert.True(t, ok)
+	assert.Equal(t, "FutureRemote", futureRemote.DeviceName)
+
+	assert.Panics(t, func() {
+		switchbot.RegisterDeviceType("Nil Device", nil)
+	})
+}
+
+func TestUnregisterDeviceType(t *testing.T) {
+	switchbot.RegisterDeviceType("Temporary Device", func(client switchbot.API) any {
+		return &FutureDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{Client: client}}
+	})
+	assert.Contains(t, switchbot.DeviceTypes(), "Temporary Device")
+
+	switchbot.UnregisterDeviceType("Temporary Device")
+	assert.NotContains(t, switchbot.DeviceTypes(), "Temporary Device")
+}
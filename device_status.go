@@ -1,7 +1,9 @@
 package switchbot
 
 import (
+	"context"
 	"encoding/json"
+	"reflect"
 )
 
 // StatusGettable is an interface that defines a method to get the status of a device as a value of type `any`
@@ -15,7 +17,24 @@ func GetDeviceStatusResponseParser(response interface{}) ResponseParser {
 		if err != nil {
 			return err
 		}
-		return nil
+
+		// Set Raw and Extra of the status body
+		value := reflect.ValueOf(response)
+		if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+			return nil
+		}
+		body := value.Elem().FieldByName("Body")
+		if !body.IsValid() || body.Kind() != reflect.Pointer || body.IsNil() {
+			return nil
+		}
+		rawResponse := struct {
+			Body json.RawMessage `json:"body"`
+		}{}
+		err = json.Unmarshal(bodyBytes, &rawResponse)
+		if err != nil {
+			return err
+		}
+		return fillRaw(body.Interface(), rawResponse.Body)
 	}
 }
 
@@ -33,8 +52,13 @@ type BotDeviceStatusResponse struct {
 }
 
 func (device *BotDevice) GetStatus() (*BotDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *BotDevice) GetStatusContext(ctx context.Context) (*BotDeviceStatusResponse, error) {
 	response := &BotDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +67,12 @@ func (device *BotDevice) GetStatus() (*BotDeviceStatusResponse, error) {
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *BotDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *BotDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +95,13 @@ type CurtainDeviceStatusResponse struct {
 }
 
 func (device *CurtainDevice) GetStatus() (*CurtainDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *CurtainDevice) GetStatusContext(ctx context.Context) (*CurtainDeviceStatusResponse, error) {
 	response := &CurtainDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +110,12 @@ func (device *CurtainDevice) GetStatus() (*CurtainDeviceStatusResponse, error) {
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *CurtainDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *CurtainDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +136,13 @@ type Hub2DeviceStatusResponse struct {
 }
 
 func (device *Hub2Device) GetStatus() (*Hub2DeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *Hub2Device) GetStatusContext(ctx context.Context) (*Hub2DeviceStatusResponse, error) {
 	response := &Hub2DeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +151,12 @@ func (device *Hub2Device) GetStatus() (*Hub2DeviceStatusResponse, error) {
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *Hub2Device) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *Hub2Device) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +179,13 @@ type Hub3DeviceStatusResponse struct {
 }
 
 func (device *Hub3Device) GetStatus() (*Hub3DeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *Hub3Device) GetStatusContext(ctx context.Context) (*Hub3DeviceStatusResponse, error) {
 	response := &Hub3DeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +194,12 @@ func (device *Hub3Device) GetStatus() (*Hub3DeviceStatusResponse, error) {
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *Hub3Device) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *Hub3Device) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +220,13 @@ type MeterDeviceStatusResponse struct {
 }
 
 func (device *MeterDevice) GetStatus() (*MeterDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *MeterDevice) GetStatusContext(ctx context.Context) (*MeterDeviceStatusResponse, error) {
 	response := &MeterDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +235,12 @@ func (device *MeterDevice) GetStatus() (*MeterDeviceStatusResponse, error) {
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *MeterDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *MeterDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -193,8 +262,13 @@ type MeterProCo2DeviceStatusResponse struct {
 }
 
 func (device *MeterProCo2Device) GetStatus() (*MeterProCo2DeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *MeterProCo2Device) GetStatusContext(ctx context.Context) (*MeterProCo2DeviceStatusResponse, error) {
 	response := &MeterProCo2DeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +277,12 @@ func (device *MeterProCo2Device) GetStatus() (*MeterProCo2DeviceStatusResponse,
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *MeterProCo2Device) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *MeterProCo2Device) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -225,8 +304,13 @@ type LockDeviceStatusResponse struct {
 }
 
 func (device *LockDevice) GetStatus() (*LockDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *LockDevice) GetStatusContext(ctx context.Context) (*LockDeviceStatusResponse, error) {
 	response := &LockDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +319,12 @@ func (device *LockDevice) GetStatus() (*LockDeviceStatusResponse, error) {
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *LockDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *LockDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -256,8 +345,13 @@ type LockLiteDeviceStatusResponse struct {
 }
 
 func (device *LockLiteDevice) GetStatus() (*LockLiteDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *LockLiteDevice) GetStatusContext(ctx context.Context) (*LockLiteDeviceStatusResponse, error) {
 	response := &LockLiteDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -266,7 +360,12 @@ func (device *LockLiteDevice) GetStatus() (*LockLiteDeviceStatusResponse, error)
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *LockLiteDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *LockLiteDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -283,8 +382,13 @@ type KeypadStatusResponse struct {
 }
 
 func (device *KeypadDevice) GetStatus() (*KeypadStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *KeypadDevice) GetStatusContext(ctx context.Context) (*KeypadStatusResponse, error) {
 	response := &KeypadStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +397,12 @@ func (device *KeypadDevice) GetStatus() (*KeypadStatusResponse, error) {
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *KeypadDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *KeypadDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -315,8 +424,13 @@ type MotionSensorDeviceStatusResponse struct {
 }
 
 func (device *MotionSensorDevice) GetStatus() (*MotionSensorDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *MotionSensorDevice) GetStatusContext(ctx context.Context) (*MotionSensorDeviceStatusResponse, error) {
 	response := &MotionSensorDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -325,7 +439,12 @@ func (device *MotionSensorDevice) GetStatus() (*MotionSensorDeviceStatusResponse
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *MotionSensorDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *MotionSensorDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -347,8 +466,13 @@ type ContactSensorDeviceStatusResponse struct {
 }
 
 func (device *ContactSensorDevice) GetStatus() (*ContactSensorDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *ContactSensorDevice) GetStatusContext(ctx context.Context) (*ContactSensorDeviceStatusResponse, error) {
 	response := &ContactSensorDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -357,7 +481,12 @@ func (device *ContactSensorDevice) GetStatus() (*ContactSensorDeviceStatusRespon
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *ContactSensorDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *ContactSensorDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -377,8 +506,13 @@ type WaterLeakDetectorDeviceStatusResponse struct {
 }
 
 func (device *WaterLeakDetectorDevice) GetStatus() (*WaterLeakDetectorDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *WaterLeakDetectorDevice) GetStatusContext(ctx context.Context) (*WaterLeakDetectorDeviceStatusResponse, error) {
 	response := &WaterLeakDetectorDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -387,7 +521,12 @@ func (device *WaterLeakDetectorDevice) GetStatus() (*WaterLeakDetectorDeviceStat
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *WaterLeakDetectorDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *WaterLeakDetectorDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -408,8 +547,13 @@ type CeilingLightDeviceStatusResponse struct {
 }
 
 func (device *CeilingLightDevice) GetStatus() (*CeilingLightDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *CeilingLightDevice) GetStatusContext(ctx context.Context) (*CeilingLightDeviceStatusResponse, error) {
 	response := &CeilingLightDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -418,7 +562,12 @@ func (device *CeilingLightDevice) GetStatus() (*CeilingLightDeviceStatusResponse
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *CeilingLightDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *CeilingLightDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -440,8 +589,13 @@ type PlugMiniDeviceStatusResponse struct {
 }
 
 func (device *PlugMiniDevice) GetStatus() (*PlugMiniDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *PlugMiniDevice) GetStatusContext(ctx context.Context) (*PlugMiniDeviceStatusResponse, error) {
 	response := &PlugMiniDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -450,7 +604,12 @@ func (device *PlugMiniDevice) GetStatus() (*PlugMiniDeviceStatusResponse, error)
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *PlugMiniDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *PlugMiniDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -469,8 +628,13 @@ type PlugDeviceStatusResponse struct {
 }
 
 func (device *PlugDevice) GetStatus() (*PlugDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *PlugDevice) GetStatusContext(ctx context.Context) (*PlugDeviceStatusResponse, error) {
 	response := &PlugDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -479,7 +643,12 @@ func (device *PlugDevice) GetStatus() (*PlugDeviceStatusResponse, error) {
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *PlugDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *PlugDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -500,8 +669,13 @@ type StripLightDeviceStatusResponse struct {
 }
 
 func (device *StripLightDevice) GetStatus() (*StripLightDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *StripLightDevice) GetStatusContext(ctx context.Context) (*StripLightDeviceStatusResponse, error) {
 	response := &StripLightDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -510,7 +684,12 @@ func (device *StripLightDevice) GetStatus() (*StripLightDeviceStatusResponse, er
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *StripLightDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *StripLightDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -532,8 +711,13 @@ type ColorLightDeviceStatusResponse struct {
 }
 
 func (device *ColorLightDevice) GetStatus() (*ColorLightDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *ColorLightDevice) GetStatusContext(ctx context.Context) (*ColorLightDeviceStatusResponse, error) {
 	response := &ColorLightDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -542,7 +726,12 @@ func (device *ColorLightDevice) GetStatus() (*ColorLightDeviceStatusResponse, er
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *ColorLightDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *ColorLightDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -562,8 +751,13 @@ type RobotVacuumCleanerDeviceStatusResponse struct {
 }
 
 func (device *RobotVacuumCleanerDevice) GetStatus() (*RobotVacuumCleanerDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *RobotVacuumCleanerDevice) GetStatusContext(ctx context.Context) (*RobotVacuumCleanerDeviceStatusResponse, error) {
 	response := &RobotVacuumCleanerDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -572,7 +766,12 @@ func (device *RobotVacuumCleanerDevice) GetStatus() (*RobotVacuumCleanerDeviceSt
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *RobotVacuumCleanerDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *RobotVacuumCleanerDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -594,8 +793,13 @@ type RobotVacuumCleanerSDeviceStatusResponse struct {
 }
 
 func (device *RobotVacuumCleanerSDevice) GetStatus() (*RobotVacuumCleanerSDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) GetStatusContext(ctx context.Context) (*RobotVacuumCleanerSDeviceStatusResponse, error) {
 	response := &RobotVacuumCleanerSDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -604,7 +808,12 @@ func (device *RobotVacuumCleanerSDevice) GetStatus() (*RobotVacuumCleanerSDevice
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *RobotVacuumCleanerSDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *RobotVacuumCleanerSDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -628,8 +837,13 @@ type RobotVacuumCleanerComboDeviceStatusResponse struct {
 
 // GetStatus retrieves the status of the RobotVacuumCleanerComboDevice
 func (device *RobotVacuumCleanerComboDevice) GetStatus() (*RobotVacuumCleanerComboDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *RobotVacuumCleanerComboDevice) GetStatusContext(ctx context.Context) (*RobotVacuumCleanerComboDeviceStatusResponse, error) {
 	response := &RobotVacuumCleanerComboDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -638,7 +852,12 @@ func (device *RobotVacuumCleanerComboDevice) GetStatus() (*RobotVacuumCleanerCom
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *RobotVacuumCleanerComboDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *RobotVacuumCleanerComboDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -663,8 +882,13 @@ type HumidifierDeviceStatusResponse struct {
 }
 
 func (device *HumidifierDevice) GetStatus() (*HumidifierDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *HumidifierDevice) GetStatusContext(ctx context.Context) (*HumidifierDeviceStatusResponse, error) {
 	response := &HumidifierDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -673,7 +897,12 @@ func (device *HumidifierDevice) GetStatus() (*HumidifierDeviceStatusResponse, er
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *HumidifierDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *HumidifierDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -702,8 +931,13 @@ type EvaporativeHumidifierDeviceStatusResponse struct {
 }
 
 func (device *EvaporativeHumidifierDevice) GetStatus() (*EvaporativeHumidifierDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *EvaporativeHumidifierDevice) GetStatusContext(ctx context.Context) (*EvaporativeHumidifierDeviceStatusResponse, error) {
 	response := &EvaporativeHumidifierDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -712,7 +946,12 @@ func (device *EvaporativeHumidifierDevice) GetStatus() (*EvaporativeHumidifierDe
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *EvaporativeHumidifierDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *EvaporativeHumidifierDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -733,8 +972,13 @@ type AirPurifierDeviceStatusResponse struct {
 }
 
 func (device *AirPurifierDevice) GetStatus() (*AirPurifierDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *AirPurifierDevice) GetStatusContext(ctx context.Context) (*AirPurifierDeviceStatusResponse, error) {
 	response := &AirPurifierDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -743,7 +987,12 @@ func (device *AirPurifierDevice) GetStatus() (*AirPurifierDeviceStatusResponse,
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *AirPurifierDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *AirPurifierDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -766,8 +1015,13 @@ type BlindTiltDeviceStatusResponse struct {
 }
 
 func (device *BlindTiltDevice) GetStatus() (*BlindTiltDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *BlindTiltDevice) GetStatusContext(ctx context.Context) (*BlindTiltDeviceStatusResponse, error) {
 	response := &BlindTiltDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -776,7 +1030,12 @@ func (device *BlindTiltDevice) GetStatus() (*BlindTiltDeviceStatusResponse, erro
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *BlindTiltDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *BlindTiltDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -802,8 +1061,13 @@ type BatteryCirculatorFanDeviceStatusResponse struct {
 }
 
 func (device *BatteryCirculatorFanDevice) GetStatus() (*BatteryCirculatorFanDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *BatteryCirculatorFanDevice) GetStatusContext(ctx context.Context) (*BatteryCirculatorFanDeviceStatusResponse, error) {
 	response := &BatteryCirculatorFanDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -812,7 +1076,12 @@ func (device *BatteryCirculatorFanDevice) GetStatus() (*BatteryCirculatorFanDevi
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *BatteryCirculatorFanDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *BatteryCirculatorFanDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -836,8 +1105,13 @@ type CirculatorFanDeviceStatusResponse struct {
 }
 
 func (device *CirculatorFanDevice) GetStatus() (*CirculatorFanDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *CirculatorFanDevice) GetStatusContext(ctx context.Context) (*CirculatorFanDeviceStatusResponse, error) {
 	response := &CirculatorFanDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -846,7 +1120,12 @@ func (device *CirculatorFanDevice) GetStatus() (*CirculatorFanDeviceStatusRespon
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *CirculatorFanDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *CirculatorFanDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -868,8 +1147,13 @@ type RollerShadeDeviceStatusResponse struct {
 }
 
 func (device *RollerShadeDevice) GetStatus() (*RollerShadeDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *RollerShadeDevice) GetStatusContext(ctx context.Context) (*RollerShadeDeviceStatusResponse, error) {
 	response := &RollerShadeDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -878,7 +1162,12 @@ func (device *RollerShadeDevice) GetStatus() (*RollerShadeDeviceStatusResponse,
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *RollerShadeDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *RollerShadeDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -901,8 +1190,13 @@ type RelaySwitch1PMDeviceStatusResponse struct {
 }
 
 func (device *RelaySwitch1PMDevice) GetStatus() (*RelaySwitch1PMDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *RelaySwitch1PMDevice) GetStatusContext(ctx context.Context) (*RelaySwitch1PMDeviceStatusResponse, error) {
 	response := &RelaySwitch1PMDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -911,7 +1205,12 @@ func (device *RelaySwitch1PMDevice) GetStatus() (*RelaySwitch1PMDeviceStatusResp
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *RelaySwitch1PMDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *RelaySwitch1PMDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -930,8 +1229,13 @@ type RelaySwitch1DeviceStatusResponse struct {
 }
 
 func (device *RelaySwitch1Device) GetStatus() (*RelaySwitch1DeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *RelaySwitch1Device) GetStatusContext(ctx context.Context) (*RelaySwitch1DeviceStatusResponse, error) {
 	response := &RelaySwitch1DeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -940,7 +1244,12 @@ func (device *RelaySwitch1Device) GetStatus() (*RelaySwitch1DeviceStatusResponse
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *RelaySwitch1Device) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *RelaySwitch1Device) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -975,8 +1284,13 @@ type RelaySwitch2PMDeviceStatusResponse struct {
 
 // GetStatus retrieves the current status of the RelaySwitch2PMDevice
 func (device *RelaySwitch2PMDevice) GetStatus() (*RelaySwitch2PMDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *RelaySwitch2PMDevice) GetStatusContext(ctx context.Context) (*RelaySwitch2PMDeviceStatusResponse, error) {
 	response := &RelaySwitch2PMDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -985,7 +1299,12 @@ func (device *RelaySwitch2PMDevice) GetStatus() (*RelaySwitch2PMDeviceStatusResp
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *RelaySwitch2PMDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *RelaySwitch2PMDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,8 +1327,13 @@ type VideoDoorbellDeviceStatusResponse struct {
 
 // GetStatus retrieves the current status of the VideoDoorbellDevice
 func (device *VideoDoorbellDevice) GetStatus() (*VideoDoorbellDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *VideoDoorbellDevice) GetStatusContext(ctx context.Context) (*VideoDoorbellDeviceStatusResponse, error) {
 	response := &VideoDoorbellDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -1018,7 +1342,12 @@ func (device *VideoDoorbellDevice) GetStatus() (*VideoDoorbellDeviceStatusRespon
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *VideoDoorbellDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *VideoDoorbellDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1041,8 +1370,13 @@ type GarageDoorOpenerDeviceStatusResponse struct {
 
 // GetStatus retrieves the current status of the GarageDoorOpenerDevice
 func (device *GarageDoorOpenerDevice) GetStatus() (*GarageDoorOpenerDeviceStatusResponse, error) {
+	return device.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context for the request
+func (device *GarageDoorOpenerDevice) GetStatusContext(ctx context.Context) (*GarageDoorOpenerDeviceStatusResponse, error) {
 	response := &GarageDoorOpenerDeviceStatusResponse{}
-	err := device.Client.GetRequest("/devices/"+device.DeviceID+"/status", GetDeviceStatusResponseParser(response))
+	err := device.Client.GetStatusContext(ctx, device.DeviceID, response)
 	if err != nil {
 		return nil, err
 	}
@@ -1051,7 +1385,12 @@ func (device *GarageDoorOpenerDevice) GetStatus() (*GarageDoorOpenerDeviceStatus
 
 // GetAnyStatusBody returns the status of the device as a value of type `any`
 func (device *GarageDoorOpenerDevice) GetAnyStatusBody() (any, error) {
-	status, err := device.GetStatus()
+	return device.GetAnyStatusBodyContext(context.Background())
+}
+
+// GetAnyStatusBodyContext is the same as GetAnyStatusBody, but uses the given context for the request
+func (device *GarageDoorOpenerDevice) GetAnyStatusBodyContext(ctx context.Context) (any, error) {
+	status, err := device.GetStatusContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1693,7 +1693,7 @@ func assertResponse(t *testing.T, response *switchbot.CommonResponse) {
 func assertBody(t *testing.T, response interface{}, expected interface{}) {
 	t.Helper()
 
-	if !reflect.DeepEqual(response, expected) {
+	if !reflect.DeepEqual(withoutRaw(response), expected) {
 		t.Fatalf("Expected body %s, got %s", jsonDump(t, expected), jsonDump(t, response))
 	}
 }
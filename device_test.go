@@ -529,7 +529,7 @@ func assertDevices(t *testing.T, response *switchbot.GetDevicesResponse, expecte
 			t.Fatalf("Expected type %T, got %T", expectedList[i], device)
 		}
 
-		if !reflect.DeepEqual(device, expectedList[i]) {
+		if !reflect.DeepEqual(withoutRaw(device), expectedList[i]) {
 			t.Fatalf("expected %s, actual %s", jsonDump(t, expectedList[i]), jsonDump(t, device))
 		}
 	}
@@ -555,12 +555,38 @@ func assertInfraredDevices(t *testing.T, response *switchbot.GetDevicesResponse,
 			t.Fatalf("Expected type %T, got %T", expectedList[i], infrared)
 		}
 
-		if !reflect.DeepEqual(infrared, expectedList[i]) {
+		if !reflect.DeepEqual(withoutRaw(infrared), expectedList[i]) {
 			t.Fatalf("expected %s, actual %s", jsonDump(t, expectedList[i]), jsonDump(t, infrared))
 		}
 	}
 }
 
+// withoutRaw returns a copy of the device or status body with Raw and Extra cleared,
+// so that it can be compared with the expected structure. Raw and Extra are tested in raw_test.go.
+func withoutRaw(data interface{}) interface{} {
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return data
+	}
+
+	copied := reflect.New(value.Elem().Type())
+	copied.Elem().Set(value.Elem())
+	clearRawFields(copied.Elem())
+	return copied.Interface()
+}
+
+func clearRawFields(value reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		switch {
+		case field.Name == "Raw" || field.Name == "Extra":
+			value.Field(i).SetZero()
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			clearRawFields(value.Field(i))
+		}
+	}
+}
+
 func jsonDump(t *testing.T, data interface{}) string {
 	t.Helper()
 
@@ -0,0 +1,157 @@
+package switchbot
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DeviceChangeKind is the kind of a DeviceChange
+type DeviceChangeKind string
+
+const (
+	// DeviceAdded means that the device exists only in the new device list
+	DeviceAdded DeviceChangeKind = "added"
+	// DeviceRemoved means that the device exists only in the old device list
+	DeviceRemoved DeviceChangeKind = "removed"
+	// DeviceRenamed means that the deviceName changed
+	DeviceRenamed DeviceChangeKind = "renamed"
+	// DeviceMoved means that the hubDeviceId changed
+	DeviceMoved DeviceChangeKind = "moved"
+	// DeviceCloudServiceDisabled means that enableCloudService changed from true to false
+	DeviceCloudServiceDisabled DeviceChangeKind = "cloudServiceDisabled"
+	// DeviceCloudServiceEnabled means that enableCloudService changed from false to true
+	DeviceCloudServiceEnabled DeviceChangeKind = "cloudServiceEnabled"
+)
+
+// DeviceChange represents a single change of a device between two device lists
+type DeviceChange struct {
+	Kind       DeviceChangeKind `json:"kind"`
+	DeviceID   string           `json:"deviceId"`
+	DeviceType string           `json:"deviceType"`
+	// DeviceName is the name in the new device list, or in the old device list if the device was removed
+	DeviceName string `json:"deviceName"`
+	// Old is the previous value for DeviceRenamed and DeviceMoved
+	Old string `json:"old,omitempty"`
+	// New is the current value for DeviceRenamed and DeviceMoved
+	New string `json:"new,omitempty"`
+}
+
+// String returns a single line description of the change
+func (change DeviceChange) String() string {
+	switch change.Kind {
+	case DeviceRenamed:
+		return fmt.Sprintf("renamed %s (%s): %q -> %q", change.DeviceID, change.DeviceType, change.Old, change.New)
+	case DeviceMoved:
+		return fmt.Sprintf("moved %s %q (%s): hub %s -> %s", change.DeviceID, change.DeviceName, change.DeviceType, change.Old, change.New)
+	default:
+		return fmt.Sprintf("%s %s %q (%s)", change.Kind, change.DeviceID, change.DeviceName, change.DeviceType)
+	}
+}
+
+// DeviceDiff is the list of changes between two device lists
+type DeviceDiff struct {
+	DeviceList         []DeviceChange `json:"deviceList"`
+	InfraredRemoteList []DeviceChange `json:"infraredRemoteList"`
+}
+
+// DiffDevices compares two device lists. A nil old is treated as an empty device list.
+// Removed devices are listed first in the order of old, followed by the other changes in the order of new.
+func DiffDevices(old, new *GetDevicesResponse) DeviceDiff {
+	if old == nil {
+		old = &GetDevicesResponse{}
+	}
+	if new == nil {
+		new = &GetDevicesResponse{}
+	}
+	return DeviceDiff{
+		DeviceList:         diffDeviceList(NewDeviceCollection(old.Body.DeviceList...), NewDeviceCollection(new.Body.DeviceList...)),
+		InfraredRemoteList: diffDeviceList(NewDeviceCollection(old.Body.InfraredRemoteList...), NewDeviceCollection(new.Body.InfraredRemoteList...)),
+	}
+}
+
+// diffDeviceList returns the changes between two lists of the same kind of devices
+func diffDeviceList(old, new *DeviceCollection) []DeviceChange {
+	changes := []DeviceChange{}
+
+	for _, oldItem := range old.items {
+		if _, err := new.FindByID(oldItem.GetDeviceID()); err != nil {
+			changes = append(changes, newDeviceChange(DeviceRemoved, oldItem))
+		}
+	}
+
+	for _, newItem := range new.items {
+		oldItem, err := old.FindByID(newItem.GetDeviceID())
+		if err != nil {
+			changes = append(changes, newDeviceChange(DeviceAdded, newItem))
+			continue
+		}
+
+		if oldItem.GetDeviceName() != newItem.GetDeviceName() {
+			change := newDeviceChange(DeviceRenamed, newItem)
+			change.Old, change.New = oldItem.GetDeviceName(), newItem.GetDeviceName()
+			changes = append(changes, change)
+		}
+		if oldItem.GetHubDeviceID() != newItem.GetHubDeviceID() {
+			change := newDeviceChange(DeviceMoved, newItem)
+			change.Old, change.New = oldItem.GetHubDeviceID(), newItem.GetHubDeviceID()
+			changes = append(changes, change)
+		}
+
+		oldCloud, oldOK := oldItem.(cloudServiceReporter)
+		newCloud, newOK := newItem.(cloudServiceReporter)
+		if oldOK && newOK && oldCloud.cloudServiceEnabled() != newCloud.cloudServiceEnabled() {
+			kind := DeviceCloudServiceDisabled
+			if newCloud.cloudServiceEnabled() {
+				kind = DeviceCloudServiceEnabled
+			}
+			changes = append(changes, newDeviceChange(kind, newItem))
+		}
+	}
+
+	return changes
+}
+
+func newDeviceChange(kind DeviceChangeKind, item DeviceListItem) DeviceChange {
+	return DeviceChange{
+		Kind:       kind,
+		DeviceID:   item.GetDeviceID(),
+		DeviceType: item.GetDeviceType(),
+		DeviceName: item.GetDeviceName(),
+	}
+}
+
+// Empty reports whether there are no changes
+func (diff DeviceDiff) Empty() bool {
+	return len(diff.DeviceList) == 0 && len(diff.InfraredRemoteList) == 0
+}
+
+// Text renders the changes as human readable text, one change per line
+func (diff DeviceDiff) Text() string {
+	if diff.Empty() {
+		return "No changes\n"
+	}
+
+	builder := strings.Builder{}
+	for _, section := range []struct {
+		title   string
+		changes []DeviceChange
+	}{
+		{title: "Devices", changes: diff.DeviceList},
+		{title: "Infrared remote devices", changes: diff.InfraredRemoteList},
+	} {
+		if len(section.changes) == 0 {
+			continue
+		}
+		builder.WriteString(section.title + ":\n")
+		for _, change := range section.changes {
+			builder.WriteString("  " + change.String() + "\n")
+		}
+	}
+	return builder.String()
+}
+
+// JSON renders the changes as indented JSON
+func (diff DeviceDiff) JSON() ([]byte, error) {
+	return json.MarshalIndent(diff, "", "  ")
+}
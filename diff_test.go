@@ -0,0 +1,90 @@
+package switchbot_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+)
+
+func newBotDevice(deviceID string, name string, hubDeviceID string, enableCloudService bool) *switchbot.BotDevice {
+	return &switchbot.BotDevice{
+		CommonDeviceListItem: switchbot.CommonDeviceListItem{
+			CommonDevice:       switchbot.CommonDevice{DeviceID: deviceID, DeviceType: "Bot", HubDeviceId: hubDeviceID},
+			DeviceName:         name,
+			EnableCloudService: enableCloudService,
+		},
+	}
+}
+
+func newInfraredTV(deviceID string, name string, hubDeviceID string) *switchbot.InfraredRemoteTVDevice {
+	return &switchbot.InfraredRemoteTVDevice{
+		InfraredRemoteDevice: switchbot.InfraredRemoteDevice{DeviceID: deviceID, DeviceName: name, RemoteType: "TV", HubDeviceId: hubDeviceID},
+	}
+}
+
+func TestDiffDevices(t *testing.T) {
+	oldResponse := &switchbot.GetDevicesResponse{
+		Body: switchbot.GetDevicesResponseBody{
+			DeviceList: []interface{}{
+				newBotDevice("BOT000000001", "Bot", "HUB000000001", true),
+				newBotDevice("BOT000000002", "Removed Bot", "HUB000000001", true),
+				newBotDevice("BOT000000003", "Old Name", "HUB000000001", true),
+			},
+			InfraredRemoteList: []interface{}{
+				newInfraredTV("02-202008110034-13", "TV", "HUB000000001"),
+			},
+		},
+	}
+	newResponse := &switchbot.GetDevicesResponse{
+		Body: switchbot.GetDevicesResponseBody{
+			DeviceList: []interface{}{
+				newBotDevice("BOT000000001", "Bot", "HUB000000001", false),
+				newBotDevice("BOT000000003", "New Name", "HUB000000002", true),
+				newBotDevice("BOT000000004", "Added Bot", "HUB000000001", true),
+			},
+			InfraredRemoteList: []interface{}{
+				newInfraredTV("02-202008110034-13", "TV", "HUB000000002"),
+			},
+		},
+	}
+
+	diff := switchbot.DiffDevices(oldResponse, newResponse)
+	assert.Equal(t, []switchbot.DeviceChange{
+		{Kind: switchbot.DeviceRemoved, DeviceID: "BOT000000002", DeviceType: "Bot", DeviceName: "Removed Bot"},
+		{Kind: switchbot.DeviceCloudServiceDisabled, DeviceID: "BOT000000001", DeviceType: "Bot", DeviceName: "Bot"},
+		{Kind: switchbot.DeviceRenamed, DeviceID: "BOT000000003", DeviceType: "Bot", DeviceName: "New Name", Old: "Old Name", New: "New Name"},
+		{Kind: switchbot.DeviceMoved, DeviceID: "BOT000000003", DeviceType: "Bot", DeviceName: "New Name", Old: "HUB000000001", New: "HUB000000002"},
+		{Kind: switchbot.DeviceAdded, DeviceID: "BOT000000004", DeviceType: "Bot", DeviceName: "Added Bot"},
+	}, diff.DeviceList)
+	assert.Equal(t, []switchbot.DeviceChange{
+		{Kind: switchbot.DeviceMoved, DeviceID: "02-202008110034-13", DeviceType: "TV", DeviceName: "TV", Old: "HUB000000001", New: "HUB000000002"},
+	}, diff.InfraredRemoteList)
+
+	t.Run("Text", func(t *testing.T) {
+		expected := `Devices:
+  removed BOT000000002 "Removed Bot" (Bot)
+  cloudServiceDisabled BOT000000001 "Bot" (Bot)
+  renamed BOT000000003 (Bot): "Old Name" -> "New Name"
+  moved BOT000000003 "New Name" (Bot): hub HUB000000001 -> HUB000000002
+  added BOT000000004 "Added Bot" (Bot)
+Infrared remote devices:
+  moved 02-202008110034-13 "TV" (TV): hub HUB000000001 -> HUB000000002
+`
+		assert.Equal(t, expected, diff.Text())
+		assert.Equal(t, "No changes\n", switchbot.DiffDevices(newResponse, newResponse).Text())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := switchbot.DiffDevices(nil, &switchbot.GetDevicesResponse{
+			Body: switchbot.GetDevicesResponseBody{
+				DeviceList: []interface{}{newBotDevice("BOT000000001", "Bot", "HUB000000001", true)},
+			},
+		}).JSON()
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"deviceList": [{"kind": "added", "deviceId": "BOT000000001", "deviceType": "Bot", "deviceName": "Bot"}],
+			"infraredRemoteList": []
+		}`, string(data))
+	})
+}
@@ -0,0 +1,167 @@
+package switchbot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// StatusCodeSuccess is the statusCode returned when the request is processed successfully
+	StatusCodeSuccess = 100
+	// StatusCodeDeviceNotFound is the statusCode returned when the device does not exist
+	StatusCodeDeviceNotFound = 152
+	// StatusCodeCommandNotSupported is the statusCode returned when the command is not supported by the device
+	StatusCodeCommandNotSupported = 160
+	// StatusCodeDeviceOffline is the statusCode returned when the device is offline
+	StatusCodeDeviceOffline = 161
+	// StatusCodeHubOffline is the statusCode returned when the hub of the device is offline
+	StatusCodeHubOffline = 171
+	// StatusCodeDeviceInternalError is the statusCode returned when the device state is not synchronized with the server or the command format is invalid
+	StatusCodeDeviceInternalError = 190
+)
+
+// Sentinel errors that can be matched against the errors returned by the Client using errors.Is
+var (
+	ErrDeviceNotFound      = errors.New("switchbot: device not found")
+	ErrCommandNotSupported = errors.New("switchbot: command not supported")
+	ErrDeviceOffline       = errors.New("switchbot: device offline")
+	ErrHubOffline          = errors.New("switchbot: hub offline")
+	ErrDeviceInternalError = errors.New("switchbot: device internal error")
+	ErrUnauthorized        = errors.New("switchbot: unauthorized")
+	ErrRateLimited         = errors.New("switchbot: rate limited")
+	ErrServerError         = errors.New("switchbot: server error")
+	// ErrQuotaExceeded is returned by the RateLimiter without sending the request when the daily quota is used up
+	ErrQuotaExceeded = errors.New("switchbot: daily quota exceeded")
+	// ErrUnsupportedSnapshotVersion is returned when loading a snapshot written in an unknown format version
+	ErrUnsupportedSnapshotVersion = errors.New("switchbot: unsupported snapshot version")
+	// ErrStatusNotSupported is returned when the status of a device cannot be retrieved, such as for an infrared remote device
+	ErrStatusNotSupported = errors.New("switchbot: status not supported")
+)
+
+// APIError represents an error returned by the SwitchBot API.
+// It is returned when the HTTP status is not 2xx or the statusCode is not 100.
+type APIError struct {
+	// HTTPStatusCode is the HTTP status code of the response
+	HTTPStatusCode int
+	// StatusCode is the statusCode in the response body. It is 0 if the body could not be parsed.
+	StatusCode int
+	// Message is the message in the response body
+	Message string
+	// DeviceID is the ID of the device the request was sent to. It is empty for requests not related to a device.
+	DeviceID string
+	// RetryAfter is the duration specified by the Retry-After header. It is 0 if the header is not present.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	var builder strings.Builder
+	builder.WriteString("switchbot: ")
+	if e.StatusCode != 0 {
+		builder.WriteString(fmt.Sprintf("statusCode %d", e.StatusCode))
+	} else {
+		builder.WriteString(fmt.Sprintf("http status %d", e.HTTPStatusCode))
+	}
+	if e.Message != "" {
+		builder.WriteString(": ")
+		builder.WriteString(e.Message)
+	}
+	if e.DeviceID != "" {
+		builder.WriteString(fmt.Sprintf(" (deviceId: %s)", e.DeviceID))
+	}
+	return builder.String()
+}
+
+// Is reports whether the error matches the target sentinel error so that it can be used with errors.Is
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrDeviceNotFound:
+		return e.StatusCode == StatusCodeDeviceNotFound
+	case ErrCommandNotSupported:
+		return e.StatusCode == StatusCodeCommandNotSupported
+	case ErrDeviceOffline:
+		return e.StatusCode == StatusCodeDeviceOffline
+	case ErrHubOffline:
+		return e.StatusCode == StatusCodeHubOffline
+	case ErrDeviceInternalError:
+		return e.StatusCode == StatusCodeDeviceInternalError
+	case ErrUnauthorized:
+		return e.HTTPStatusCode == http.StatusUnauthorized
+	case ErrRateLimited:
+		return e.HTTPStatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.HTTPStatusCode >= 500 && e.HTTPStatusCode <= 599
+	default:
+		return false
+	}
+}
+
+// checkResponse returns an *APIError if the response indicates a failure
+func checkResponse(resp *http.Response, path string, bodyBytes []byte) error {
+	httpStatusCode := resp.StatusCode
+	response := struct {
+		StatusCode *int   `json:"statusCode"`
+		Message    string `json:"message"`
+	}{}
+	parseErr := json.Unmarshal(bodyBytes, &response)
+
+	if httpStatusCode < 200 || httpStatusCode > 299 {
+		apiError := &APIError{
+			HTTPStatusCode: httpStatusCode,
+			Message:        response.Message,
+			DeviceID:       deviceIDFromPath(path),
+			RetryAfter:     parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+		if parseErr != nil || apiError.Message == "" {
+			apiError.Message = http.StatusText(httpStatusCode)
+		}
+		if parseErr == nil && response.StatusCode != nil {
+			apiError.StatusCode = *response.StatusCode
+		}
+		return apiError
+	}
+
+	// MEMO: Bodies without statusCode are left to the parser.
+	if parseErr == nil && response.StatusCode != nil && *response.StatusCode != StatusCodeSuccess {
+		return &APIError{
+			HTTPStatusCode: httpStatusCode,
+			StatusCode:     *response.StatusCode,
+			Message:        response.Message,
+			DeviceID:       deviceIDFromPath(path),
+		}
+	}
+
+	return nil
+}
+
+// deviceIDFromPath extracts the deviceId from a path such as "/devices/{deviceId}/status"
+func deviceIDFromPath(path string) string {
+	if !strings.HasPrefix(path, "/devices/") {
+		return ""
+	}
+	deviceID, _, _ := strings.Cut(strings.TrimPrefix(path, "/devices/"), "/")
+	return deviceID
+}
+
+// parseRetryAfter parses the value of the Retry-After header, which is either delay-seconds or an HTTP-date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
@@ -0,0 +1,160 @@
+package switchbot_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+)
+
+func TestAPIError(t *testing.T) {
+	sentinels := []error{
+		switchbot.ErrDeviceNotFound,
+		switchbot.ErrCommandNotSupported,
+		switchbot.ErrDeviceOffline,
+		switchbot.ErrHubOffline,
+		switchbot.ErrDeviceInternalError,
+		switchbot.ErrUnauthorized,
+		switchbot.ErrRateLimited,
+		switchbot.ErrServerError,
+	}
+
+	testDataList := []struct {
+		name           string
+		httpStatusCode int
+		responseBody   string
+		call           func(*switchbot.Client) error
+		expectedError  *switchbot.APIError
+		sentinel       error
+	}{
+		{
+			name:           "DeviceNotFound",
+			httpStatusCode: http.StatusOK,
+			responseBody:   `{"statusCode":152,"body":{},"message":"device not found"}`,
+			call: func(client *switchbot.Client) error {
+				device := &switchbot.BotDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"}, Client: client}}
+				_, err := device.GetStatus()
+				return err
+			},
+			expectedError: &switchbot.APIError{HTTPStatusCode: 200, StatusCode: 152, Message: "device not found", DeviceID: "ABCDEF123456"},
+			sentinel:      switchbot.ErrDeviceNotFound,
+		},
+		{
+			name:           "CommandNotSupported",
+			httpStatusCode: http.StatusOK,
+			responseBody:   `{"statusCode":160,"body":{},"message":"command is not supported"}`,
+			call: func(client *switchbot.Client) error {
+				device := &switchbot.BotDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"}, Client: client}}
+				_, err := device.Press()
+				return err
+			},
+			expectedError: &switchbot.APIError{HTTPStatusCode: 200, StatusCode: 160, Message: "command is not supported", DeviceID: "ABCDEF123456"},
+			sentinel:      switchbot.ErrCommandNotSupported,
+		},
+		{
+			name:           "DeviceOffline",
+			httpStatusCode: http.StatusOK,
+			responseBody:   `{"statusCode":161,"body":{},"message":"device offline"}`,
+			call: func(client *switchbot.Client) error {
+				device := &switchbot.BotDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"}, Client: client}}
+				_, err := device.TurnOn()
+				return err
+			},
+			expectedError: &switchbot.APIError{HTTPStatusCode: 200, StatusCode: 161, Message: "device offline", DeviceID: "ABCDEF123456"},
+			sentinel:      switchbot.ErrDeviceOffline,
+		},
+		{
+			name:           "HubOffline",
+			httpStatusCode: http.StatusOK,
+			responseBody:   `{"statusCode":171,"body":{},"message":"hub offline"}`,
+			call: func(client *switchbot.Client) error {
+				device := &switchbot.BotDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"}, Client: client}}
+				_, err := device.TurnOff()
+				return err
+			},
+			expectedError: &switchbot.APIError{HTTPStatusCode: 200, StatusCode: 171, Message: "hub offline", DeviceID: "ABCDEF123456"},
+			sentinel:      switchbot.ErrHubOffline,
+		},
+		{
+			name:           "DeviceInternalError",
+			httpStatusCode: http.StatusOK,
+			responseBody:   `{"statusCode":190,"body":{},"message":"Device internal error due to device states not synchronized with server"}`,
+			call: func(client *switchbot.Client) error {
+				scene := &switchbot.Scene{Client: client, SceneID: "T02-202009221414-48924101"}
+				_, err := scene.Execute()
+				return err
+			},
+			expectedError: &switchbot.APIError{HTTPStatusCode: 200, StatusCode: 190, Message: "Device internal error due to device states not synchronized with server"},
+			sentinel:      switchbot.ErrDeviceInternalError,
+		},
+		{
+			name:           "Unauthorized",
+			httpStatusCode: http.StatusUnauthorized,
+			responseBody:   `{"message":"Unauthorized"}`,
+			call: func(client *switchbot.Client) error {
+				_, err := client.GetDevices()
+				return err
+			},
+			expectedError: &switchbot.APIError{HTTPStatusCode: 401, Message: "Unauthorized"},
+			sentinel:      switchbot.ErrUnauthorized,
+		},
+		{
+			name:           "RateLimited",
+			httpStatusCode: http.StatusTooManyRequests,
+			responseBody:   `Too Many Requests`,
+			call: func(client *switchbot.Client) error {
+				_, err := client.GetDevices()
+				return err
+			},
+			expectedError: &switchbot.APIError{HTTPStatusCode: 429, Message: "Too Many Requests"},
+			sentinel:      switchbot.ErrRateLimited,
+		},
+		{
+			name:           "ServerError",
+			httpStatusCode: http.StatusBadGateway,
+			responseBody:   ``,
+			call: func(client *switchbot.Client) error {
+				_, err := client.GetScenes()
+				return err
+			},
+			expectedError: &switchbot.APIError{HTTPStatusCode: 502, Message: "Bad Gateway"},
+			sentinel:      switchbot.ErrServerError,
+		},
+	}
+
+	for _, testData := range testDataList {
+		t.Run(testData.name, func(t *testing.T) {
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(testData.httpStatusCode)
+				_, _ = w.Write([]byte(testData.responseBody))
+			}))
+			defer testServer.Close()
+
+			client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+			err := testData.call(client)
+
+			var apiError *switchbot.APIError
+			if !errors.As(err, &apiError) {
+				t.Fatalf("Expected *switchbot.APIError, got %T: %v", err, err)
+			}
+			assert.Equal(t, testData.expectedError, apiError)
+			assert.ErrorIs(t, err, testData.sentinel)
+			for _, sentinel := range sentinels {
+				if sentinel != testData.sentinel {
+					assert.NotErrorIs(t, err, sentinel)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	err := &switchbot.APIError{HTTPStatusCode: 200, StatusCode: 161, Message: "device offline", DeviceID: "ABCDEF123456"}
+	assert.Equal(t, "switchbot: statusCode 161: device offline (deviceId: ABCDEF123456)", err.Error())
+
+	err = &switchbot.APIError{HTTPStatusCode: 429, Message: "Too Many Requests"}
+	assert.Equal(t, "switchbot: http status 429: Too Many Requests", err.Error())
+}
@@ -24,17 +24,13 @@ func main() {
 		log.Fatalf("Error: %v", err)
 	}
 
-	for _, infraredRemoteDevice := range response.Body.InfraredRemoteList {
-		switch infraredRemoteDevice.(type) {
-		case *switchbot.InfraredRemoteLightDevice:
-			lightDevice := infraredRemoteDevice.(*switchbot.InfraredRemoteLightDevice)
-			log.Printf("Light. DeviceID:%s, DeviceName:%s, RemoteType:%s", lightDevice.DeviceID, lightDevice.DeviceName, lightDevice.RemoteType)
+	for _, lightDevice := range switchbot.DevicesOf[*switchbot.InfraredRemoteLightDevice](response.Collection()) {
+		log.Printf("Light. DeviceID:%s, DeviceName:%s, RemoteType:%s", lightDevice.DeviceID, lightDevice.DeviceName, lightDevice.RemoteType)
 
-			commandResponse, err := lightDevice.TurnOn()
-			if err != nil {
-				log.Fatalf("Error: %v", err)
-			}
-			log.Printf("StatusCode: %d, Message: %s", commandResponse.StatusCode, commandResponse.Message)
+		commandResponse, err := lightDevice.TurnOn()
+		if err != nil {
+			log.Fatalf("Error: %v", err)
 		}
+		log.Printf("StatusCode: %d, Message: %s", commandResponse.StatusCode, commandResponse.Message)
 	}
 }
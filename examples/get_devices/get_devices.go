@@ -24,17 +24,14 @@ func main() {
 		log.Fatalf("Error: %v", err)
 	}
 
-	for _, device := range response.Body.DeviceList {
-		switch device.(type) {
-		case *switchbot.BotDevice:
-			device := device.(*switchbot.BotDevice)
-			log.Printf("Bot Device. DeviceID:%s, DeviceName:%s", device.DeviceID, device.DeviceName)
-		case *switchbot.HubDevice:
-			device := device.(*switchbot.HubDevice)
-			log.Printf("Hub Device. DeviceID:%s, DeviceName:%s", device.DeviceID, device.DeviceName)
-		case *switchbot.MeterDevice:
-			device := device.(*switchbot.MeterDevice)
-			log.Printf("Meter Device. DeviceID:%s, DeviceName:%s", device.DeviceID, device.DeviceName)
-		}
+	devices := response.Collection()
+	for _, device := range switchbot.DevicesOf[*switchbot.BotDevice](devices) {
+		log.Printf("Bot Device. DeviceID:%s, DeviceName:%s", device.DeviceID, device.DeviceName)
+	}
+	for _, device := range switchbot.DevicesOf[*switchbot.HubDevice](devices) {
+		log.Printf("Hub Device. DeviceID:%s, DeviceName:%s", device.DeviceID, device.DeviceName)
+	}
+	for _, device := range switchbot.DevicesOf[*switchbot.MeterDevice](devices) {
+		log.Printf("Meter Device. DeviceID:%s, DeviceName:%s", device.DeviceID, device.DeviceName)
 	}
 }
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/yasu89/switch-bot-api-go/webhook"
+)
+
+func main() {
+	handler := webhook.NewHandler()
+
+	webhook.On(handler, func(event *webhook.LockEvent) {
+		log.Printf("Lock Event. DeviceMac:%s, LockState:%s", event.DeviceMac, event.LockState)
+	})
+	webhook.On(handler, func(event *webhook.KeypadCreateKeyResultEvent) {
+		log.Printf("Keypad CreateKey Result. DeviceMac:%s, CommandId:%s, Result:%s", event.DeviceMac, event.CommandId, event.Result)
+	})
+	handler.OnEvent(func(event webhook.Event) {
+		log.Printf("Event. DeviceType:%s, DeviceMac:%s", event.GetDeviceType(), event.GetDeviceMac())
+	})
+
+	http.Handle("/webhook", handler)
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
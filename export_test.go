@@ -0,0 +1,7 @@
+package switchbot
+
+// Hooks that expose unexported functions to the tests in the switchbot_test package
+var (
+	UnregisterDeviceType         = unregisterDeviceType
+	UnregisterInfraredRemoteType = unregisterInfraredRemoteType
+)
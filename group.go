@@ -0,0 +1,455 @@
+package switchbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// groupInfo describes the group a device belongs to
+type groupInfo struct {
+	group     bool
+	master    bool
+	memberIDs []string
+	// statusFields is the list of status fields (JSON names) that all members are expected to agree on
+	statusFields []string
+}
+
+// Groupable is implemented by the devices that can be grouped in the SwitchBot app,
+// such as a pair of curtains or two locks on the same door
+type Groupable interface {
+	DeviceListItem
+	GetAnyStatusBodyContext(ctx context.Context) (any, error)
+	groupInfo() groupInfo
+}
+
+func (device *CurtainDevice) groupInfo() groupInfo {
+	return groupInfo{
+		group:        device.Group,
+		master:       device.Master,
+		memberIDs:    device.CurtainDevicesIds,
+		statusFields: []string{"slidePosition", "moving"},
+	}
+}
+
+func (device *LockDevice) groupInfo() groupInfo {
+	return groupInfo{
+		group:        device.Group,
+		master:       device.Master,
+		memberIDs:    device.LockDevicesIds,
+		statusFields: []string{"lockState", "doorState"},
+	}
+}
+
+func (device *LockLiteDevice) groupInfo() groupInfo {
+	return groupInfo{
+		group:        device.Group,
+		master:       device.Master,
+		memberIDs:    device.LockDevicesIds,
+		statusFields: []string{"lockState"},
+	}
+}
+
+func (device *BlindTiltDevice) groupInfo() groupInfo {
+	return groupInfo{
+		group:        device.Group,
+		master:       device.Master,
+		memberIDs:    device.BlindTiltDevicesIds,
+		statusFields: []string{"slidePosition", "direction", "moving"},
+	}
+}
+
+func (device *RollerShadeDevice) groupInfo() groupInfo {
+	return groupInfo{
+		group:        device.Group,
+		master:       device.Master,
+		memberIDs:    device.GroupingDevicesIds,
+		statusFields: []string{"slidePosition", "moving"},
+	}
+}
+
+// DeviceGroup represents a group of devices that operate together.
+// Commands sent to the Master are applied to the whole group by SwitchBot.
+// The group types such as CurtainGroup send the commands the device supports to the Master.
+type DeviceGroup[T Groupable] struct {
+	Master T
+	// Members is the list of all devices in the group, including the Master
+	Members []T
+	// MissingMemberIDs is the list of member IDs that are not in the device list
+	MissingMemberIDs []string
+}
+
+// GroupsOf resolves the groups of devices of type T in the collection, such as GroupsOf[*CurtainDevice].
+// Devices that do not belong to a group are not included.
+func GroupsOf[T Groupable](collection *DeviceCollection) []*DeviceGroup[T] {
+	var groups []*DeviceGroup[T]
+	seen := map[string]bool{}
+
+	for _, device := range DevicesOf[T](collection) {
+		info := device.groupInfo()
+		if !info.group && len(info.memberIDs) < 2 {
+			continue
+		}
+
+		memberIDs := info.memberIDs
+		if !slices.Contains(memberIDs, device.GetDeviceID()) {
+			memberIDs = append([]string{device.GetDeviceID()}, memberIDs...)
+		}
+		sortedIDs := slices.Clone(memberIDs)
+		slices.Sort(sortedIDs)
+		key := strings.Join(sortedIDs, ",")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		group := &DeviceGroup[T]{}
+		masterFound := false
+		for _, memberID := range memberIDs {
+			member, err := FindOf[T](collection, memberID)
+			if err != nil {
+				group.MissingMemberIDs = append(group.MissingMemberIDs, memberID)
+				continue
+			}
+			group.Members = append(group.Members, member)
+			if member.groupInfo().master && !masterFound {
+				group.Master = member
+				masterFound = true
+			}
+		}
+		// MEMO: If no member is flagged as the master, the first member is used so that commands can still be sent.
+		// The device itself is always a member, so Members is never empty.
+		if !masterFound {
+			group.Master = group.Members[0]
+		}
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// CurtainGroup is a group of CurtainDevice
+type CurtainGroup struct {
+	*DeviceGroup[*CurtainDevice]
+}
+
+// CurtainGroupsOf resolves the groups of CurtainDevice in the collection
+func CurtainGroupsOf(collection *DeviceCollection) []*CurtainGroup {
+	var groups []*CurtainGroup
+	for _, group := range GroupsOf[*CurtainDevice](collection) {
+		groups = append(groups, &CurtainGroup{DeviceGroup: group})
+	}
+	return groups
+}
+
+// TurnOn sends a command to turn on the curtain group through the Master
+func (group *CurtainGroup) TurnOn() (*CommonResponse, error) {
+	return group.TurnOnContext(context.Background())
+}
+
+// TurnOnContext is the same as TurnOn, but uses the given context for the request
+func (group *CurtainGroup) TurnOnContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.TurnOnContext(ctx)
+}
+
+// TurnOff sends a command to turn off the curtain group through the Master
+func (group *CurtainGroup) TurnOff() (*CommonResponse, error) {
+	return group.TurnOffContext(context.Background())
+}
+
+// TurnOffContext is the same as TurnOff, but uses the given context for the request
+func (group *CurtainGroup) TurnOffContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.TurnOffContext(ctx)
+}
+
+// Pause sends a command to pause the curtain group through the Master
+func (group *CurtainGroup) Pause() (*CommonResponse, error) {
+	return group.PauseContext(context.Background())
+}
+
+// PauseContext is the same as Pause, but uses the given context for the request
+func (group *CurtainGroup) PauseContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.PauseContext(ctx)
+}
+
+// SetPosition sends a command to move the curtain group to the given position through the Master
+func (group *CurtainGroup) SetPosition(mode CurtainPositionMode, position int) (*CommonResponse, error) {
+	return group.SetPositionContext(context.Background(), mode, position)
+}
+
+// SetPositionContext is the same as SetPosition, but uses the given context for the request
+func (group *CurtainGroup) SetPositionContext(ctx context.Context, mode CurtainPositionMode, position int) (*CommonResponse, error) {
+	return group.Master.SetPositionContext(ctx, mode, position)
+}
+
+// SetPositionPercent sends a command to move the group to the given position, where 0 is fully open and 100 is fully closed, through the Master
+func (group *CurtainGroup) SetPositionPercent(position int) (*CommonResponse, error) {
+	return group.SetPositionPercentContext(context.Background(), position)
+}
+
+// SetPositionPercentContext is the same as SetPositionPercent, but uses the given context for the request
+func (group *CurtainGroup) SetPositionPercentContext(ctx context.Context, position int) (*CommonResponse, error) {
+	return group.Master.SetPositionPercentContext(ctx, position)
+}
+
+// LockGroup is a group of LockDevice
+type LockGroup struct {
+	*DeviceGroup[*LockDevice]
+}
+
+// LockGroupsOf resolves the groups of LockDevice in the collection
+func LockGroupsOf(collection *DeviceCollection) []*LockGroup {
+	var groups []*LockGroup
+	for _, group := range GroupsOf[*LockDevice](collection) {
+		groups = append(groups, &LockGroup{DeviceGroup: group})
+	}
+	return groups
+}
+
+// Lock sends a command to lock the lock group through the Master
+func (group *LockGroup) Lock() (*CommonResponse, error) {
+	return group.LockContext(context.Background())
+}
+
+// LockContext is the same as Lock, but uses the given context for the request
+func (group *LockGroup) LockContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.LockContext(ctx)
+}
+
+// Unlock sends a command to unlock the lock group through the Master
+func (group *LockGroup) Unlock() (*CommonResponse, error) {
+	return group.UnlockContext(context.Background())
+}
+
+// UnlockContext is the same as Unlock, but uses the given context for the request
+func (group *LockGroup) UnlockContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.UnlockContext(ctx)
+}
+
+// LockLiteGroup is a group of LockLiteDevice
+type LockLiteGroup struct {
+	*DeviceGroup[*LockLiteDevice]
+}
+
+// LockLiteGroupsOf resolves the groups of LockLiteDevice in the collection
+func LockLiteGroupsOf(collection *DeviceCollection) []*LockLiteGroup {
+	var groups []*LockLiteGroup
+	for _, group := range GroupsOf[*LockLiteDevice](collection) {
+		groups = append(groups, &LockLiteGroup{DeviceGroup: group})
+	}
+	return groups
+}
+
+// Lock sends a command to lock the lock group through the Master
+func (group *LockLiteGroup) Lock() (*CommonResponse, error) {
+	return group.LockContext(context.Background())
+}
+
+// LockContext is the same as Lock, but uses the given context for the request
+func (group *LockLiteGroup) LockContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.LockContext(ctx)
+}
+
+// Unlock sends a command to unlock the lock group through the Master
+func (group *LockLiteGroup) Unlock() (*CommonResponse, error) {
+	return group.UnlockContext(context.Background())
+}
+
+// UnlockContext is the same as Unlock, but uses the given context for the request
+func (group *LockLiteGroup) UnlockContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.UnlockContext(ctx)
+}
+
+// BlindTiltGroup is a group of BlindTiltDevice
+type BlindTiltGroup struct {
+	*DeviceGroup[*BlindTiltDevice]
+}
+
+// BlindTiltGroupsOf resolves the groups of BlindTiltDevice in the collection
+func BlindTiltGroupsOf(collection *DeviceCollection) []*BlindTiltGroup {
+	var groups []*BlindTiltGroup
+	for _, group := range GroupsOf[*BlindTiltDevice](collection) {
+		groups = append(groups, &BlindTiltGroup{DeviceGroup: group})
+	}
+	return groups
+}
+
+// SetPosition sends a command to tilt the blind tilt group to the given direction and position through the Master
+func (group *BlindTiltGroup) SetPosition(direction string, position int) (*CommonResponse, error) {
+	return group.SetPositionContext(context.Background(), direction, position)
+}
+
+// SetPositionContext is the same as SetPosition, but uses the given context for the request
+func (group *BlindTiltGroup) SetPositionContext(ctx context.Context, direction string, position int) (*CommonResponse, error) {
+	return group.Master.SetPositionContext(ctx, direction, position)
+}
+
+// FullyOpen sends a command to open the blind tilt group through the Master
+func (group *BlindTiltGroup) FullyOpen() (*CommonResponse, error) {
+	return group.FullyOpenContext(context.Background())
+}
+
+// FullyOpenContext is the same as FullyOpen, but uses the given context for the request
+func (group *BlindTiltGroup) FullyOpenContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.FullyOpenContext(ctx)
+}
+
+// CloseUp sends a command to close the blind tilt group upward through the Master
+func (group *BlindTiltGroup) CloseUp() (*CommonResponse, error) {
+	return group.CloseUpContext(context.Background())
+}
+
+// CloseUpContext is the same as CloseUp, but uses the given context for the request
+func (group *BlindTiltGroup) CloseUpContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.CloseUpContext(ctx)
+}
+
+// CloseDown sends a command to close the blind tilt group downward through the Master
+func (group *BlindTiltGroup) CloseDown() (*CommonResponse, error) {
+	return group.CloseDownContext(context.Background())
+}
+
+// CloseDownContext is the same as CloseDown, but uses the given context for the request
+func (group *BlindTiltGroup) CloseDownContext(ctx context.Context) (*CommonResponse, error) {
+	return group.Master.CloseDownContext(ctx)
+}
+
+// SetPositionPercent sends a command to move the group to the given position, where 0 is fully open and 100 is fully closed, through the Master
+func (group *BlindTiltGroup) SetPositionPercent(position int) (*CommonResponse, error) {
+	return group.SetPositionPercentContext(context.Background(), position)
+}
+
+// SetPositionPercentContext is the same as SetPositionPercent, but uses the given context for the request
+func (group *BlindTiltGroup) SetPositionPercentContext(ctx context.Context, position int) (*CommonResponse, error) {
+	return group.Master.SetPositionPercentContext(ctx, position)
+}
+
+// RollerShadeGroup is a group of RollerShadeDevice
+type RollerShadeGroup struct {
+	*DeviceGroup[*RollerShadeDevice]
+}
+
+// RollerShadeGroupsOf resolves the groups of RollerShadeDevice in the collection
+func RollerShadeGroupsOf(collection *DeviceCollection) []*RollerShadeGroup {
+	var groups []*RollerShadeGroup
+	for _, group := range GroupsOf[*RollerShadeDevice](collection) {
+		groups = append(groups, &RollerShadeGroup{DeviceGroup: group})
+	}
+	return groups
+}
+
+// SetPosition sends a command to move the roller shade group to the given position through the Master
+func (group *RollerShadeGroup) SetPosition(position int) (*CommonResponse, error) {
+	return group.SetPositionContext(context.Background(), position)
+}
+
+// SetPositionContext is the same as SetPosition, but uses the given context for the request
+func (group *RollerShadeGroup) SetPositionContext(ctx context.Context, position int) (*CommonResponse, error) {
+	return group.Master.SetPositionContext(ctx, position)
+}
+
+// SetPositionPercent sends a command to move the group to the given position, where 0 is fully open and 100 is fully closed, through the Master
+func (group *RollerShadeGroup) SetPositionPercent(position int) (*CommonResponse, error) {
+	return group.SetPositionPercentContext(context.Background(), position)
+}
+
+// SetPositionPercentContext is the same as SetPositionPercent, but uses the given context for the request
+func (group *RollerShadeGroup) SetPositionPercentContext(ctx context.Context, position int) (*CommonResponse, error) {
+	return group.Master.SetPositionPercentContext(ctx, position)
+}
+
+// GroupDisagreement represents a status field whose value differs between the members of a group
+type GroupDisagreement struct {
+	// Field is the JSON name of the status field, such as "slidePosition"
+	Field string
+	// Values is the value of the field for each member ID
+	Values map[string]any
+}
+
+// GroupStatus represents the statuses of all members of a group
+type GroupStatus struct {
+	// Statuses is the status body of each member ID
+	Statuses map[string]any
+	// Errors is the error returned for each member ID whose status could not be retrieved
+	Errors map[string]error
+	// Disagreements is the list of status fields whose values differ between the members
+	Disagreements []GroupDisagreement
+}
+
+// Consistent reports whether all statuses were retrieved and all members agree
+func (status *GroupStatus) Consistent() bool {
+	return len(status.Errors) == 0 && len(status.Disagreements) == 0
+}
+
+// GetStatuses retrieves the status of every member of the group and compares them
+func (group *DeviceGroup[T]) GetStatuses() (*GroupStatus, error) {
+	return group.GetStatusesContext(context.Background())
+}
+
+// GetStatusesContext is the same as GetStatuses, but uses the given context for the request.
+// The GroupStatus is returned even if some members fail, together with an error that joins their errors.
+func (group *DeviceGroup[T]) GetStatusesContext(ctx context.Context) (*GroupStatus, error) {
+	status := &GroupStatus{
+		Statuses: map[string]any{},
+		Errors:   map[string]error{},
+	}
+
+	var errs []error
+	for _, member := range group.Members {
+		body, err := member.GetAnyStatusBodyContext(ctx)
+		if err != nil {
+			status.Errors[member.GetDeviceID()] = err
+			errs = append(errs, fmt.Errorf("failed to get status of %s: %w", member.GetDeviceID(), err))
+			continue
+		}
+		status.Statuses[member.GetDeviceID()] = body
+	}
+
+	disagreements, err := compareStatuses(group.Master.groupInfo().statusFields, status.Statuses)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	status.Disagreements = disagreements
+
+	return status, errors.Join(errs...)
+}
+
+// compareStatuses returns the fields whose values differ between the statuses
+func compareStatuses(fields []string, statuses map[string]any) ([]GroupDisagreement, error) {
+	values := map[string]map[string]any{}
+	for deviceID, body := range statuses {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		decoded := map[string]any{}
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return nil, err
+		}
+		values[deviceID] = decoded
+	}
+
+	var disagreements []GroupDisagreement
+	for _, field := range fields {
+		fieldValues := map[string]any{}
+		var first any
+		agree := true
+		for deviceID, decoded := range values {
+			value := decoded[field]
+			if len(fieldValues) == 0 {
+				first = value
+			} else if !reflect.DeepEqual(first, value) {
+				agree = false
+			}
+			fieldValues[deviceID] = value
+		}
+		if !agree {
+			disagreements = append(disagreements, GroupDisagreement{Field: field, Values: fieldValues})
+		}
+	}
+	return disagreements, nil
+}
@@ -0,0 +1,227 @@
+package switchbot_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestGroupsOf(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterDevicesMock(
+		[]interface{}{
+			map[string]interface{}{
+				"deviceId":          "CURTAIN00001",
+				"deviceType":        "Curtain",
+				"hubDeviceId":       "HUB000000001",
+				"deviceName":        "Curtain Left",
+				"curtainDevicesIds": []string{"CURTAIN00001", "CURTAIN00002"},
+				"group":             true,
+				"master":            false,
+			},
+			map[string]interface{}{
+				"deviceId":          "CURTAIN00002",
+				"deviceType":        "Curtain",
+				"hubDeviceId":       "HUB000000001",
+				"deviceName":        "Curtain Right",
+				"curtainDevicesIds": []string{"CURTAIN00001", "CURTAIN00002"},
+				"group":             true,
+				"master":            true,
+			},
+			map[string]interface{}{
+				"deviceId":          "CURTAIN00003",
+				"deviceType":        "Curtain",
+				"hubDeviceId":       "HUB000000001",
+				"deviceName":        "Single Curtain",
+				"curtainDevicesIds": []string{"CURTAIN00003"},
+				"group":             false,
+				"master":            true,
+			},
+			map[string]interface{}{
+				"deviceId":       "LOCK00000001",
+				"deviceType":     "Smart Lock",
+				"hubDeviceId":    "HUB000000001",
+				"deviceName":     "Front Door",
+				"lockDevicesIds": []string{"LOCK00000001", "LOCK00000002"},
+				"group":          true,
+				"master":         true,
+			},
+		},
+		[]interface{}{},
+	)
+	switchBotMock.RegisterStatusMock("CURTAIN00001", map[string]interface{}{
+		"deviceId":      "CURTAIN00001",
+		"deviceType":    "Curtain",
+		"slidePosition": "30",
+		"moving":        false,
+	})
+	switchBotMock.RegisterStatusMock("CURTAIN00002", map[string]interface{}{
+		"deviceId":      "CURTAIN00002",
+		"deviceType":    "Curtain",
+		"slidePosition": "100",
+		"moving":        false,
+	})
+	switchBotMock.RegisterStatusMock("LOCK00000001", map[string]interface{}{
+		"deviceId":   "LOCK00000001",
+		"deviceType": "Smart Lock",
+		"lockState":  "locked",
+		"doorState":  "closed",
+	})
+	switchBotMock.RegisterCommandMock("CURTAIN00002", `{"commandType":"command","command":"turnOff","parameter":"default"}`)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	response, err := client.GetDevices()
+	assert.NoError(t, err)
+	devices := response.Collection()
+
+	t.Run("CurtainGroup", func(t *testing.T) {
+		groups := switchbot.CurtainGroupsOf(devices)
+		assert.Len(t, groups, 1)
+		group := groups[0]
+		assert.Equal(t, "CURTAIN00002", group.Master.DeviceID)
+		assert.Len(t, group.Members, 2)
+		assert.Empty(t, group.MissingMemberIDs)
+
+		_, err := group.Master.TurnOff()
+		assert.NoError(t, err)
+		switchBotMock.AssertCallCount(http.MethodPost, "/devices/CURTAIN00002/commands", 1)
+
+		status, err := group.GetStatuses()
+		assert.NoError(t, err)
+		assert.False(t, status.Consistent())
+		assert.Len(t, status.Statuses, 2)
+		assert.Equal(t, []switchbot.GroupDisagreement{
+			{
+				Field:  "slidePosition",
+				Values: map[string]any{"CURTAIN00001": "30", "CURTAIN00002": "100"},
+			},
+		}, status.Disagreements)
+	})
+
+	t.Run("LockGroupWithMissingMember", func(t *testing.T) {
+		groups := switchbot.LockGroupsOf(devices)
+		assert.Len(t, groups, 1)
+		group := groups[0]
+		assert.Equal(t, "LOCK00000001", group.Master.DeviceID)
+		assert.Equal(t, []string{"LOCK00000002"}, group.MissingMemberIDs)
+
+		status, err := group.GetStatuses()
+		assert.NoError(t, err)
+		assert.True(t, status.Consistent())
+	})
+
+	t.Run("NoGroups", func(t *testing.T) {
+		assert.Empty(t, switchbot.RollerShadeGroupsOf(devices))
+		assert.Len(t, switchbot.GroupsOf[*switchbot.CurtainDevice](devices), 1)
+	})
+}
+
+func TestDeviceGroupCommands(t *testing.T) {
+	emulator := helpers.NewEmulator("token", "secret")
+	for _, deviceID := range []string{"CURTAIN00001", "CURTAIN00002"} {
+		emulator.AddDevice(
+			map[string]interface{}{
+				"deviceId":          deviceID,
+				"deviceType":        "Curtain",
+				"hubDeviceId":       "HUB000000001",
+				"deviceName":        deviceID,
+				"curtainDevicesIds": []string{"CURTAIN00001", "CURTAIN00002"},
+				"group":             true,
+				"master":            deviceID == "CURTAIN00002",
+			},
+			map[string]interface{}{"slidePosition": "0", "moving": false},
+		)
+	}
+	for _, deviceID := range []string{"BLINDTILT001", "BLINDTILT002"} {
+		emulator.AddDevice(
+			map[string]interface{}{
+				"deviceId":            deviceID,
+				"deviceType":          "Blind Tilt",
+				"hubDeviceId":         "HUB000000001",
+				"deviceName":          deviceID,
+				"blindTiltDevicesIds": []string{"BLINDTILT001", "BLINDTILT002"},
+				"group":               true,
+				"master":              deviceID == "BLINDTILT001",
+			},
+			map[string]interface{}{"direction": "up", "slidePosition": 50},
+		)
+	}
+	for _, deviceID := range []string{"LOCK00000001", "LOCK00000002"} {
+		emulator.AddDevice(
+			map[string]interface{}{
+				"deviceId":       deviceID,
+				"deviceType":     "Smart Lock",
+				"hubDeviceId":    "HUB000000001",
+				"deviceName":     deviceID,
+				"lockDevicesIds": []string{"LOCK00000001", "LOCK00000002"},
+				"group":          true,
+				"master":         deviceID == "LOCK00000001",
+			},
+			map[string]interface{}{"lockState": "unlocked", "doorState": "closed"},
+		)
+	}
+	testServer := emulator.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	response, err := client.GetDevices()
+	assert.NoError(t, err)
+	devices := response.Collection()
+
+	commandsOf := func(deviceID string) []string {
+		var commands []string
+		for _, request := range emulator.Commands(deviceID) {
+			commands = append(commands, fmt.Sprintf("%s %v", request.Command, request.Parameter))
+		}
+		return commands
+	}
+
+	t.Run("CurtainGroup", func(t *testing.T) {
+		group := switchbot.CurtainGroupsOf(devices)[0]
+		_, err := group.TurnOn()
+		assert.NoError(t, err)
+		_, err = group.TurnOff()
+		assert.NoError(t, err)
+		_, err = group.Pause()
+		assert.NoError(t, err)
+		_, err = group.SetPosition(switchbot.CurtainPositionModeSilent, 30)
+		assert.NoError(t, err)
+		_, err = group.SetPositionPercent(60)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"turnOn default", "turnOff default", "pause default", "setPosition 0,1,30", "setPosition 0,ff,60"}, commandsOf("CURTAIN00002"))
+		assert.Empty(t, commandsOf("CURTAIN00001"))
+	})
+
+	t.Run("BlindTiltGroup", func(t *testing.T) {
+		group := switchbot.BlindTiltGroupsOf(devices)[0]
+		_, err := group.FullyOpen()
+		assert.NoError(t, err)
+		_, err = group.CloseUp()
+		assert.NoError(t, err)
+		_, err = group.CloseDown()
+		assert.NoError(t, err)
+		_, err = group.SetPosition("down", 40)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"fullyOpen default", "closeUp default", "closeDown default", "setPosition down;40"}, commandsOf("BLINDTILT001"))
+		assert.Empty(t, commandsOf("BLINDTILT002"))
+	})
+
+	t.Run("LockGroup", func(t *testing.T) {
+		group := switchbot.LockGroupsOf(devices)[0]
+		_, err := group.Lock()
+		assert.NoError(t, err)
+		_, err = group.Unlock()
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"lock default", "unlock default"}, commandsOf("LOCK00000001"))
+		assert.Empty(t, commandsOf("LOCK00000002"))
+	})
+}
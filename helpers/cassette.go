@@ -0,0 +1,263 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/yasu89/switch-bot-api-go"
+)
+
+// CassetteMode determines whether a Cassette sends requests to the server or replays the recorded responses
+type CassetteMode int
+
+const (
+	// CassetteModeReplay replays the recorded responses without sending requests to the server
+	CassetteModeReplay CassetteMode = iota
+	// CassetteModeRecord sends requests to the server and records the responses
+	CassetteModeRecord
+)
+
+// scrubbedHeaders is the set of request headers that are not written to the cassette
+var scrubbedHeaders = map[string]bool{
+	"Authorization": true,
+	"Sign":          true,
+	"Nonce":         true,
+	"T":             true,
+}
+
+// CassetteRequest represents a recorded request
+type CassetteRequest struct {
+	Method string `json:"method"`
+	// Path is the path relative to the base API URL, such as "/devices"
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// CassetteResponse represents a recorded response
+type CassetteResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       json.RawMessage   `json:"body,omitempty"`
+}
+
+// Interaction represents a pair of a recorded request and response
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// Cassette records the requests sent by the Client and their responses to a JSON file, and replays them offline.
+// Use Cassette.Middleware with switchbot.OptionMiddleware.
+// The paths are recorded relative to the base API URL, so a cassette can be replayed with another base API URL.
+// Secrets in the request bodies, such as keypad passwords, are replaced with switchbot.RedactedValue.
+type Cassette struct {
+	Path         string
+	Mode         CassetteMode
+	Interactions []*Interaction
+	// AllowRepeats makes the replay reuse the last matching interaction once all matching interactions are used
+	AllowRepeats bool
+
+	mu   sync.Mutex
+	used map[*Interaction]bool
+}
+
+// NewCassette creates a Cassette for the given file.
+// In CassetteModeReplay the recorded interactions are loaded from the file.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	cassette := &Cassette{
+		Path: path,
+		Mode: mode,
+		used: map[*Interaction]bool{},
+	}
+	if mode == CassetteModeRecord {
+		return cassette, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	if err := json.Unmarshal(data, &cassette.Interactions); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette: %w", err)
+	}
+	return cassette, nil
+}
+
+// Save writes the recorded interactions to the file
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c.Interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.Path, data, 0o644)
+}
+
+// Middleware wraps the transport of the Client to record or replay the interactions
+func (c *Cassette) Middleware(next http.RoundTripper) http.RoundTripper {
+	return switchbot.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requestBody, err := readRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if c.Mode == CassetteModeRecord {
+			return c.record(next, req, requestBody)
+		}
+		return c.replay(req, requestBody)
+	})
+}
+
+// record sends the request to the server and appends the interaction to the cassette
+func (c *Cassette) record(next http.RoundTripper, req *http.Request, requestBody []byte) (*http.Response, error) {
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		Request: CassetteRequest{
+			Method:  req.Method,
+			Path:    switchbot.RequestPath(req),
+			Headers: flattenHeader(req.Header, scrubbedHeaders),
+			Body:    rawJSON(redactBody(requestBody)),
+		},
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    flattenHeader(resp.Header, nil),
+			Body:       rawJSON(responseBody),
+		},
+	}
+
+	c.mu.Lock()
+	c.Interactions = append(c.Interactions, interaction)
+	c.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction that matches the method, path and normalized body.
+// The secrets in the body are redacted before matching, as they are in the recorded body.
+func (c *Cassette) replay(req *http.Request, requestBody []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.used == nil {
+		c.used = map[*Interaction]bool{}
+	}
+
+	path := switchbot.RequestPath(req)
+	body := normalizeBody(redactBody(requestBody))
+	var lastMatch *Interaction
+	for _, interaction := range c.Interactions {
+		if interaction.Request.Method != req.Method || interaction.Request.Path != path || normalizeBody(interaction.Request.Body) != body {
+			continue
+		}
+		lastMatch = interaction
+		if !c.used[interaction] {
+			c.used[interaction] = true
+			return interaction.Response.toHTTPResponse(req), nil
+		}
+	}
+
+	if lastMatch != nil && c.AllowRepeats {
+		return lastMatch.Response.toHTTPResponse(req), nil
+	}
+	return nil, fmt.Errorf("cassette: no interaction recorded for %s %s %s", req.Method, path, body)
+}
+
+func (r CassetteResponse) toHTTPResponse(req *http.Request) *http.Response {
+	header := http.Header{}
+	for key, value := range r.Headers {
+		header.Set(key, value)
+	}
+	body := []byte(r.Body)
+	// MEMO: A body that is not valid JSON is recorded as a JSON string by rawJSON.
+	var text string
+	if json.Unmarshal(r.Body, &text) == nil {
+		body = []byte(text)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func flattenHeader(header http.Header, excluded map[string]bool) map[string]string {
+	flattened := map[string]string{}
+	for key := range header {
+		if excluded[http.CanonicalHeaderKey(key)] {
+			continue
+		}
+		flattened[key] = header.Get(key)
+	}
+	return flattened
+}
+
+// redactBody returns the body with the secrets replaced, or nil if the body is empty
+func redactBody(body []byte) []byte {
+	if len(body) == 0 {
+		return nil
+	}
+	return []byte(switchbot.RedactBody(body))
+}
+
+// rawJSON returns the body as json.RawMessage, or as a JSON string if it is not valid JSON
+func rawJSON(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		return body
+	}
+	encoded, _ := json.Marshal(string(body))
+	return encoded
+}
+
+// normalizeBody re-encodes the JSON body so that whitespace and key order do not affect the matching
+func normalizeBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	normalized, _ := json.Marshal(value)
+	return string(normalized)
+}
@@ -0,0 +1,77 @@
+package helpers_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	// Record
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterScenesMock([]interface{}{
+		map[string]interface{}{"sceneId": "scene-1", "sceneName": "Good Morning"},
+	})
+	switchBotMock.RegisterCommandMock("ABCDEF123456", `{"commandType":"command","command":"turnOn","parameter":"default"}`)
+	createKey := switchbot.ControlRequest{
+		CommandType: "command",
+		Command:     "createKey",
+		Parameter:   map[string]any{"name": "Guest", "type": "permanent", "password": "12345678"},
+	}
+	switchBotMock.RegisterCommandMock("KEYPAD000001", `{"commandType":"command","command":"createKey","parameter":{"name":"Guest","type":"permanent","password":"12345678"}}`)
+	testServer := switchBotMock.NewTestServer()
+
+	recorder, err := helpers.NewCassette(path, helpers.CassetteModeRecord)
+	assert.NoError(t, err)
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionMiddleware(recorder.Middleware))
+
+	scenes, err := client.GetScenes()
+	assert.NoError(t, err)
+	assert.Equal(t, "Good Morning", scenes.Body[0].SceneName)
+	_, err = client.SendCommand("ABCDEF123456", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+	assert.NoError(t, err)
+	_, err = client.SendCommand("KEYPAD000001", createKey)
+	assert.NoError(t, err)
+	assert.NoError(t, recorder.Save())
+	testServer.Close()
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), `"Authorization"`)
+	assert.NotContains(t, string(data), `"Sign"`)
+	assert.NotContains(t, string(data), `"Nonce"`)
+	assert.NotContains(t, string(data), "12345678")
+	assert.Contains(t, string(data), switchbot.RedactedValue)
+
+	// Replay without the server, under another base API URL
+	player, err := helpers.NewCassette(path, helpers.CassetteModeReplay)
+	assert.NoError(t, err)
+	assert.Len(t, player.Interactions, 3)
+	assert.Equal(t, "/scenes", player.Interactions[0].Request.Path)
+	client = switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL+"/v1.1"), switchbot.OptionMiddleware(player.Middleware))
+
+	scenes, err = client.GetScenes()
+	assert.NoError(t, err)
+	assert.Equal(t, "scene-1", scenes.Body[0].SceneID)
+
+	_, err = client.SendCommand("ABCDEF123456", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+	assert.NoError(t, err)
+	_, err = client.SendCommand("KEYPAD000001", createKey)
+	assert.NoError(t, err)
+
+	_, err = client.GetScenes()
+	assert.ErrorContains(t, err, "cassette: no interaction recorded for GET /scenes")
+
+	_, err = client.SendCommand("ABCDEF123456", switchbot.ControlRequest{CommandType: "command", Command: "turnOff", Parameter: "default"})
+	assert.ErrorContains(t, err, "cassette: no interaction recorded")
+
+	player.AllowRepeats = true
+	_, err = client.GetScenes()
+	assert.NoError(t, err)
+}
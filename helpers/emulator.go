@@ -0,0 +1,491 @@
+package helpers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/yasu89/switch-bot-api-go"
+)
+
+// EmulatorCommand updates the status of a device for a command.
+// It returns an error if the parameter is invalid, which is reported as statusCode 190.
+type EmulatorCommand func(status map[string]interface{}, parameter string) error
+
+// Emulator is a stateful emulator of the SwitchBot cloud API.
+// It is seeded with a device inventory, and each command updates the status of the device,
+// so that tests can check the behavior end-to-end instead of the shape of the requests.
+type Emulator struct {
+	token  string
+	secret string
+
+	mu              sync.Mutex
+	devices         []map[string]interface{}
+	infraredRemotes []map[string]interface{}
+	statuses        map[string]map[string]interface{}
+	history         map[string][]switchbot.ControlRequest
+	commands        map[string]map[string]EmulatorCommand
+}
+
+// NewEmulator creates a new Emulator that accepts the requests signed with the given token and secret.
+// The built-in commands of the major device types are registered.
+func NewEmulator(token string, secret string) *Emulator {
+	emulator := &Emulator{
+		token:    token,
+		secret:   secret,
+		statuses: map[string]map[string]interface{}{},
+		history:  map[string][]switchbot.ControlRequest{},
+		commands: map[string]map[string]EmulatorCommand{},
+	}
+	emulator.registerBuiltinCommands()
+	return emulator
+}
+
+// AddDevice adds a physical device with its initial status.
+// The device is the JSON object of the device list, and must have "deviceId" and "deviceType".
+func (e *Emulator) AddDevice(device map[string]interface{}, status map[string]interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	deviceID, _ := device["deviceId"].(string)
+	e.devices = append(e.devices, maps.Clone(device))
+	initial := maps.Clone(status)
+	if initial == nil {
+		initial = map[string]interface{}{}
+	}
+	initial["deviceId"] = deviceID
+	initial["deviceType"] = device["deviceType"]
+	initial["hubDeviceId"] = device["hubDeviceId"]
+	e.statuses[deviceID] = initial
+}
+
+// AddInfraredRemote adds an infrared remote device, which accepts any command and has no status
+func (e *Emulator) AddInfraredRemote(remote map[string]interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.infraredRemotes = append(e.infraredRemotes, maps.Clone(remote))
+}
+
+// Status returns a copy of the current status of the device
+func (e *Emulator) Status(deviceID string) map[string]interface{} {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return maps.Clone(e.statuses[deviceID])
+}
+
+// UpdateStatus changes the status of the device, such as to emulate a change made with a physical button
+func (e *Emulator) UpdateStatus(deviceID string, fields map[string]interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if status, ok := e.statuses[deviceID]; ok {
+		maps.Copy(status, fields)
+	}
+}
+
+// Commands returns the commands the device has received, in order
+func (e *Emulator) Commands(deviceID string) []switchbot.ControlRequest {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]switchbot.ControlRequest{}, e.history[deviceID]...)
+}
+
+// HandleCommand registers or overrides the command of the deviceType.
+// Once a deviceType has any command, the commands not registered for it fail with statusCode 160.
+// The deviceTypes without commands accept any command without changing the status.
+func (e *Emulator) HandleCommand(deviceType string, command string, handler EmulatorCommand) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.commands[deviceType] == nil {
+		e.commands[deviceType] = map[string]EmulatorCommand{}
+	}
+	e.commands[deviceType][command] = handler
+}
+
+// NewTestServer creates a new test server backed by the emulator
+func (e *Emulator) NewTestServer() *httptest.Server {
+	return httptest.NewServer(e)
+}
+
+// ServeHTTP handles a request to the SwitchBot API
+func (e *Emulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !e.verifySignature(r) {
+		writeEmulatorResponse(w, http.StatusUnauthorized, map[string]interface{}{"message": "Unauthorized"})
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	path := strings.Trim(r.URL.Path, "/")
+	segments := strings.Split(path, "/")
+	switch {
+	case r.Method == http.MethodGet && path == "devices":
+		e.writeSuccess(w, map[string]interface{}{
+			"deviceList":         e.devices,
+			"infraredRemoteList": e.infraredRemotes,
+		})
+	case r.Method == http.MethodGet && len(segments) == 3 && segments[0] == "devices" && segments[2] == "status":
+		status, ok := e.statuses[segments[1]]
+		if !ok {
+			e.writeStatusCode(w, switchbot.StatusCodeDeviceNotFound, "device not found")
+			return
+		}
+		e.writeSuccess(w, status)
+	case r.Method == http.MethodPost && len(segments) == 3 && segments[0] == "devices" && segments[2] == "commands":
+		e.handleCommand(w, r, segments[1])
+	default:
+		writeEmulatorResponse(w, http.StatusNotFound, map[string]interface{}{"message": "Not Found"})
+	}
+}
+
+// verifySignature checks the headers with the same HMAC scheme as the Client
+func (e *Emulator) verifySignature(r *http.Request) bool {
+	if r.Header.Get("Authorization") != e.token {
+		return false
+	}
+	timestamp := r.Header.Get("t")
+	nonce := r.Header.Get("nonce")
+	if timestamp == "" || nonce == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(e.secret))
+	mac.Write([]byte(e.token + timestamp + nonce))
+	expected := strings.ToUpper(base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return hmac.Equal([]byte(expected), []byte(r.Header.Get("sign")))
+}
+
+func (e *Emulator) handleCommand(w http.ResponseWriter, r *http.Request, deviceID string) {
+	var request switchbot.ControlRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		e.writeStatusCode(w, switchbot.StatusCodeDeviceInternalError, "invalid request body")
+		return
+	}
+
+	if e.isInfraredRemote(deviceID) {
+		e.history[deviceID] = append(e.history[deviceID], request)
+		e.writeSuccess(w, map[string]interface{}{})
+		return
+	}
+
+	status, ok := e.statuses[deviceID]
+	if !ok {
+		e.writeStatusCode(w, switchbot.StatusCodeDeviceNotFound, "device not found")
+		return
+	}
+
+	deviceType, _ := status["deviceType"].(string)
+	commands, hasCommands := e.commands[deviceType]
+	if hasCommands {
+		handler, ok := commands[request.Command]
+		if !ok || request.CommandType != "command" {
+			e.writeStatusCode(w, switchbot.StatusCodeCommandNotSupported, "command not supported")
+			return
+		}
+		if err := handler(status, fmt.Sprint(request.Parameter)); err != nil {
+			e.writeStatusCode(w, switchbot.StatusCodeDeviceInternalError, err.Error())
+			return
+		}
+	}
+
+	e.history[deviceID] = append(e.history[deviceID], request)
+	e.writeSuccess(w, map[string]interface{}{})
+}
+
+func (e *Emulator) isInfraredRemote(deviceID string) bool {
+	for _, remote := range e.infraredRemotes {
+		if remote["deviceId"] == deviceID {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Emulator) writeSuccess(w http.ResponseWriter, body interface{}) {
+	writeEmulatorResponse(w, http.StatusOK, map[string]interface{}{
+		"statusCode": switchbot.StatusCodeSuccess,
+		"message":    "success",
+		"body":       body,
+	})
+}
+
+func (e *Emulator) writeStatusCode(w http.ResponseWriter, statusCode int, message string) {
+	writeEmulatorResponse(w, http.StatusOK, map[string]interface{}{
+		"statusCode": statusCode,
+		"message":    message,
+		"body":       map[string]interface{}{},
+	})
+}
+
+func writeEmulatorResponse(w http.ResponseWriter, httpStatusCode int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusCode)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// setValue sets the key to the value, keeping the JSON type of the current value.
+// For example, the slidePosition of a curtain is a string while that of a roller shade is a number.
+func setValue(status map[string]interface{}, key string, value int) {
+	if _, isString := status[key].(string); isString {
+		status[key] = strconv.Itoa(value)
+		return
+	}
+	status[key] = value
+}
+
+// parseRange parses the parameter as an integer from min to max
+func parseRange(parameter string, min int, max int) (int, error) {
+	value, err := strconv.Atoi(strings.TrimSpace(parameter))
+	if err != nil || value < min || value > max {
+		return 0, fmt.Errorf("invalid parameter %q: must be %d to %d", parameter, min, max)
+	}
+	return value, nil
+}
+
+func setField(key string, value interface{}) EmulatorCommand {
+	return func(status map[string]interface{}, parameter string) error {
+		status[key] = value
+		return nil
+	}
+}
+
+func toggleField(key string, on interface{}, off interface{}) EmulatorCommand {
+	return func(status map[string]interface{}, parameter string) error {
+		if sameValue(status[key], on) {
+			status[key] = off
+		} else {
+			status[key] = on
+		}
+		return nil
+	}
+}
+
+// sameValue reports whether the values are equal, comparing numbers by value
+// so that the int of a built-in command matches the float64 of a status decoded from JSON
+func sameValue(a interface{}, b interface{}) bool {
+	aNumber, aIsNumber := toFloat64(a)
+	bNumber, bIsNumber := toFloat64(b)
+	if aIsNumber && bIsNumber {
+		return aNumber == bNumber
+	}
+	return a == b
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func noop(status map[string]interface{}, parameter string) error {
+	return nil
+}
+
+func (e *Emulator) handleCommands(deviceTypes []string, commands map[string]EmulatorCommand) {
+	for _, deviceType := range deviceTypes {
+		for command, handler := range commands {
+			e.HandleCommand(deviceType, command, handler)
+		}
+	}
+}
+
+// registerBuiltinCommands registers the commands of the major device types based on device_control.go.
+// Every command sent by device_control.go for these device types must be registered, even if it does not change the status.
+func (e *Emulator) registerBuiltinCommands() {
+	power := map[string]EmulatorCommand{
+		"turnOn":  setField("power", "on"),
+		"turnOff": setField("power", "off"),
+	}
+	powerWithToggle := map[string]EmulatorCommand{
+		"turnOn":  setField("power", "on"),
+		"turnOff": setField("power", "off"),
+		"toggle":  toggleField("power", "on", "off"),
+	}
+
+	e.handleCommands([]string{"Bot"}, map[string]EmulatorCommand{
+		"turnOn":  setField("power", "on"),
+		"turnOff": setField("power", "off"),
+		"press":   noop,
+	})
+
+	// MEMO: TurnOn and TurnOff of curtains are equivalent to setting the position to 100 and 0 (see CurtainDeviceCommandParameter).
+	e.handleCommands([]string{"Curtain", "Curtain3"}, map[string]EmulatorCommand{
+		"turnOn": func(status map[string]interface{}, parameter string) error {
+			setValue(status, "slidePosition", 100)
+			return nil
+		},
+		"turnOff": func(status map[string]interface{}, parameter string) error {
+			setValue(status, "slidePosition", 0)
+			return nil
+		},
+		"pause": setField("moving", false),
+		"setPosition": func(status map[string]interface{}, parameter string) error {
+			parts := strings.Split(parameter, ",")
+			if len(parts) != 3 {
+				return fmt.Errorf("invalid parameter %q: must be index,mode,position", parameter)
+			}
+			position, err := parseRange(parts[2], 0, 100)
+			if err != nil {
+				return err
+			}
+			setValue(status, "slidePosition", position)
+			return nil
+		},
+	})
+
+	e.handleCommands([]string{"Smart Lock", "Smart Lock Pro", "Smart Lock Ultra", "Smart Lock Lite"}, map[string]EmulatorCommand{
+		"lock":   setField("lockState", "locked"),
+		"unlock": setField("lockState", "unlocked"),
+	})
+
+	lightCommands := map[string]EmulatorCommand{
+		"turnOn":  setField("power", "on"),
+		"turnOff": setField("power", "off"),
+		"toggle":  toggleField("power", "on", "off"),
+		"setBrightness": func(status map[string]interface{}, parameter string) error {
+			brightness, err := parseRange(parameter, 1, 100)
+			if err != nil {
+				return err
+			}
+			setValue(status, "brightness", brightness)
+			return nil
+		},
+	}
+	colorTemperature := func(status map[string]interface{}, parameter string) error {
+		colorTemperature, err := parseRange(parameter, 2700, 6500)
+		if err != nil {
+			return err
+		}
+		setValue(status, "colorTemperature", colorTemperature)
+		return nil
+	}
+	color := func(status map[string]interface{}, parameter string) error {
+		parts := strings.Split(parameter, ":")
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid parameter %q: must be r:g:b", parameter)
+		}
+		for _, part := range parts {
+			if _, err := parseRange(part, 0, 255); err != nil {
+				return err
+			}
+		}
+		status["color"] = parameter
+		return nil
+	}
+
+	ceilingLightCommands := maps.Clone(lightCommands)
+	ceilingLightCommands["setColorTemperature"] = colorTemperature
+	e.handleCommands([]string{"Ceiling Light", "Ceiling Light Pro"}, ceilingLightCommands)
+
+	stripLightCommands := maps.Clone(lightCommands)
+	stripLightCommands["setColor"] = color
+	e.handleCommands([]string{"Strip Light"}, stripLightCommands)
+
+	colorLightCommands := maps.Clone(lightCommands)
+	colorLightCommands["setColor"] = color
+	colorLightCommands["setColorTemperature"] = colorTemperature
+	e.handleCommands([]string{"Color Bulb", "Floor Lamp", "Strip Light 3"}, colorLightCommands)
+
+	e.handleCommands([]string{"Plug"}, power)
+	e.handleCommands([]string{"Plug Mini (US)", "Plug Mini (JP)"}, powerWithToggle)
+
+	humidifierCommands := maps.Clone(power)
+	humidifierCommands["setMode"] = noop
+	e.handleCommands([]string{"Humidifier"}, humidifierCommands)
+
+	airPurifierCommands := maps.Clone(power)
+	airPurifierCommands["setMode"] = noop
+	airPurifierCommands["setChildLock"] = noop
+	e.handleCommands([]string{"Humidifier2", "Air Purifier VOC", "Air Purifier Table VOC", "Air Purifier PM2.5", "Air Purifier Table PM2.5"}, airPurifierCommands)
+
+	fanCommands := maps.Clone(power)
+	fanCommands["setNightLightMode"] = noop
+	fanCommands["setWindMode"] = noop
+	fanCommands["setWindSpeed"] = func(status map[string]interface{}, parameter string) error {
+		fanSpeed, err := parseRange(parameter, 1, 100)
+		if err != nil {
+			return err
+		}
+		setValue(status, "fanSpeed", fanSpeed)
+		return nil
+	}
+	e.handleCommands([]string{"Battery Circulator Fan", "Circulator Fan"}, fanCommands)
+
+	e.handleCommands([]string{"Relay Switch 1", "Relay Switch 1PM"}, map[string]EmulatorCommand{
+		"turnOn":  setField("switchStatus", 1),
+		"turnOff": setField("switchStatus", 0),
+		"toggle":  toggleField("switchStatus", 1, 0),
+		"setMode": noop,
+	})
+
+	// MEMO: The slidePosition of Blind Tilt follows the position of setPosition, which is 0 when closed and 100 when open.
+	// fullyOpen is the same as up;100 (or down;100), closeUp is the same as up;0 and closeDown is the same as down;0.
+	blindTiltPosition := func(status map[string]interface{}, direction string, position int) {
+		status["direction"] = direction
+		setValue(status, "slidePosition", position)
+	}
+	e.handleCommands([]string{"Blind Tilt"}, map[string]EmulatorCommand{
+		"setPosition": func(status map[string]interface{}, parameter string) error {
+			direction, position, ok := strings.Cut(parameter, ";")
+			if !ok || (direction != "up" && direction != "down") {
+				return fmt.Errorf("invalid parameter %q: must be direction;position", parameter)
+			}
+			value, err := parseRange(position, 0, 100)
+			if err != nil {
+				return err
+			}
+			blindTiltPosition(status, direction, value)
+			return nil
+		},
+		"fullyOpen": func(status map[string]interface{}, parameter string) error {
+			direction, _ := status["direction"].(string)
+			if direction != "down" {
+				direction = "up"
+			}
+			blindTiltPosition(status, direction, 100)
+			return nil
+		},
+		"closeUp": func(status map[string]interface{}, parameter string) error {
+			blindTiltPosition(status, "up", 0)
+			return nil
+		},
+		"closeDown": func(status map[string]interface{}, parameter string) error {
+			blindTiltPosition(status, "down", 0)
+			return nil
+		},
+	})
+
+	e.handleCommands([]string{"Roller Shade"}, map[string]EmulatorCommand{
+		"setPosition": func(status map[string]interface{}, parameter string) error {
+			position, err := parseRange(parameter, 0, 100)
+			if err != nil {
+				return err
+			}
+			setValue(status, "slidePosition", position)
+			return nil
+		},
+	})
+
+	// MEMO: doorStatus is 0 when the door is open and 1 when it is closed.
+	e.handleCommands([]string{"Garage Door Opener"}, map[string]EmulatorCommand{
+		"turnOn":  setField("doorStatus", 0),
+		"turnOff": setField("doorStatus", 1),
+	})
+}
@@ -0,0 +1,164 @@
+package helpers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestEmulator(t *testing.T) {
+	emulator := helpers.NewEmulator("token", "secret")
+	emulator.AddDevice(
+		map[string]interface{}{"deviceId": "CEILING00001", "deviceType": "Ceiling Light", "hubDeviceId": "HUB000000001", "deviceName": "Ceiling"},
+		map[string]interface{}{"power": "off", "brightness": 50, "colorTemperature": 4000},
+	)
+	emulator.AddDevice(
+		map[string]interface{}{"deviceId": "CURTAIN00001", "deviceType": "Curtain", "hubDeviceId": "HUB000000001", "deviceName": "Curtain"},
+		map[string]interface{}{"slidePosition": "0", "moving": false},
+	)
+	emulator.AddDevice(
+		map[string]interface{}{"deviceId": "LOCK00000001", "deviceType": "Smart Lock", "hubDeviceId": "HUB000000001", "deviceName": "Lock"},
+		map[string]interface{}{"lockState": "unlocked", "doorState": "closed"},
+	)
+	emulator.AddInfraredRemote(map[string]interface{}{"deviceId": "02-202008110034-13", "deviceName": "TV", "remoteType": "TV", "hubDeviceId": "HUB000000001"})
+	testServer := emulator.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	response, err := client.GetDevices()
+	assert.NoError(t, err)
+	devices := response.Collection()
+
+	t.Run("CeilingLight", func(t *testing.T) {
+		light, err := switchbot.FindOf[*switchbot.CeilingLightDevice](devices, "CEILING00001")
+		assert.NoError(t, err)
+
+		_, err = light.TurnOn()
+		assert.NoError(t, err)
+		_, err = light.SetBrightness(80)
+		assert.NoError(t, err)
+
+		status, err := light.GetStatus()
+		assert.NoError(t, err)
+		assert.Equal(t, "on", status.Body.Power)
+		assert.Equal(t, 80, status.Body.Brightness)
+		assert.Equal(t, 4000, status.Body.ColorTemperature)
+
+		_, err = client.SendCommand("CEILING00001", switchbot.ControlRequest{CommandType: "command", Command: "setBrightness", Parameter: "0"})
+		assert.ErrorIs(t, err, switchbot.ErrDeviceInternalError)
+	})
+
+	t.Run("Curtain", func(t *testing.T) {
+		curtain, err := switchbot.FindOf[*switchbot.CurtainDevice](devices, "CURTAIN00001")
+		assert.NoError(t, err)
+
+		_, err = curtain.SetPosition(switchbot.CurtainPositionModeDefault, 70)
+		assert.NoError(t, err)
+		status, err := curtain.GetStatus()
+		assert.NoError(t, err)
+		assert.Equal(t, "70", status.Body.SlidePosition)
+	})
+
+	t.Run("Lock", func(t *testing.T) {
+		lock, err := switchbot.FindOf[*switchbot.LockDevice](devices, "LOCK00000001")
+		assert.NoError(t, err)
+
+		_, err = lock.Lock()
+		assert.NoError(t, err)
+		assert.Equal(t, "locked", emulator.Status("LOCK00000001")["lockState"])
+
+		emulator.UpdateStatus("LOCK00000001", map[string]interface{}{"lockState": "jammed"})
+		status, err := lock.GetStatus()
+		assert.NoError(t, err)
+		assert.Equal(t, "jammed", status.Body.LockState)
+
+		_, err = client.SendCommand("LOCK00000001", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+		assert.ErrorIs(t, err, switchbot.ErrCommandNotSupported)
+		assert.Len(t, emulator.Commands("LOCK00000001"), 1)
+	})
+
+	t.Run("InfraredRemote", func(t *testing.T) {
+		tv, err := switchbot.FindOf[*switchbot.InfraredRemoteTVDevice](devices, "02-202008110034-13")
+		assert.NoError(t, err)
+
+		_, err = tv.TurnOn()
+		assert.NoError(t, err)
+		assert.Equal(t, []switchbot.ControlRequest{{CommandType: "command", Command: "turnOn", Parameter: "default"}}, emulator.Commands("02-202008110034-13"))
+	})
+
+	t.Run("DeviceNotFound", func(t *testing.T) {
+		_, err := client.SendCommand("UNKNOWN00001", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+		assert.ErrorIs(t, err, switchbot.ErrDeviceNotFound)
+	})
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		invalidClient := switchbot.NewClient("wrong-secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+		_, err := invalidClient.GetDevices()
+		assert.ErrorIs(t, err, switchbot.ErrUnauthorized)
+	})
+}
+
+func TestEmulatorBlindTiltPosition(t *testing.T) {
+	emulator := helpers.NewEmulator("token", "secret")
+	for _, deviceID := range []string{"BLINDTILT001", "BLINDTILT002"} {
+		emulator.AddDevice(
+			map[string]interface{}{"deviceId": deviceID, "deviceType": "Blind Tilt", "hubDeviceId": "HUB000000001", "deviceName": deviceID},
+			map[string]interface{}{"direction": "up", "slidePosition": 50},
+		)
+	}
+	testServer := emulator.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	response, err := client.GetDevices()
+	assert.NoError(t, err)
+	first, err := switchbot.FindOf[*switchbot.BlindTiltDevice](response.Collection(), "BLINDTILT001")
+	assert.NoError(t, err)
+	second, err := switchbot.FindOf[*switchbot.BlindTiltDevice](response.Collection(), "BLINDTILT002")
+	assert.NoError(t, err)
+
+	testDataList := []struct {
+		name      string
+		command   func() (*switchbot.CommonResponse, error)
+		direction string
+		position  int
+	}{
+		{name: "fullyOpen", command: first.FullyOpen, direction: "up", position: 100},
+		{name: "closeUp", command: first.CloseUp, direction: "up", position: 0},
+		{name: "closeDown", command: first.CloseDown, direction: "down", position: 0},
+	}
+	for _, testData := range testDataList {
+		t.Run(testData.name, func(t *testing.T) {
+			_, err := testData.command()
+			assert.NoError(t, err)
+			_, err = second.SetPosition(testData.direction, testData.position)
+			assert.NoError(t, err)
+
+			for _, field := range []string{"direction", "slidePosition"} {
+				assert.Equal(t, emulator.Status("BLINDTILT002")[field], emulator.Status("BLINDTILT001")[field])
+			}
+			assert.Equal(t, testData.position, emulator.Status("BLINDTILT001")["slidePosition"])
+		})
+	}
+}
+
+func TestEmulatorToggleJSONStatus(t *testing.T) {
+	status := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"switchStatus":1}`), &status))
+
+	emulator := helpers.NewEmulator("token", "secret")
+	emulator.AddDevice(
+		map[string]interface{}{"deviceId": "RELAY0000001", "deviceType": "Relay Switch 1", "hubDeviceId": "HUB000000001", "deviceName": "Relay"},
+		status,
+	)
+	testServer := emulator.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	_, err := client.SendCommand("RELAY0000001", switchbot.ControlRequest{CommandType: "command", Command: "toggle", Parameter: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, emulator.Status("RELAY0000001")["switchStatus"])
+}
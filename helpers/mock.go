@@ -1,10 +1,13 @@
 package helpers
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/yasu89/switch-bot-api-go"
@@ -31,22 +34,67 @@ func (h *HttpMockHandler) IsMatch(method string, path string) bool {
 	return true
 }
 
+// RecordedRequest is a request received by the SwitchBotMock.
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
 // SwitchBotMock is a mock for the SwitchBot API.
+// Failures detected while serving requests are reported with t.Errorf, which is safe to call from the server goroutine.
 type SwitchBotMock struct {
 	t        *testing.T
 	handlers []*HttpMockHandler
+
+	mu       sync.Mutex
+	requests []RecordedRequest
+	faults   map[string]*faultSequence
 }
 
 // NewSwitchBotMock creates a new instance of SwitchBotMock.
 func NewSwitchBotMock(t *testing.T) *SwitchBotMock {
 	return &SwitchBotMock{
-		t: t,
+		t:      t,
+		faults: map[string]*faultSequence{},
+	}
+}
+
+// writeResponse writes the response as JSON, reporting marshal and write errors with t.Errorf.
+func (s *SwitchBotMock) writeResponse(w http.ResponseWriter, response interface{}) {
+	responseJsonText, err := json.Marshal(response)
+	if err != nil {
+		s.t.Errorf("Failed to marshal response: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(responseJsonText); err != nil {
+		s.t.Errorf("Failed to write response: %v", err)
+	}
+}
+
+// writeSuccessResponse writes a success response with an empty body.
+func (s *SwitchBotMock) writeSuccessResponse(w http.ResponseWriter) {
+	s.writeResponse(w, map[string]interface{}{
+		"statusCode": switchbot.StatusCodeSuccess,
+		"body":       map[string]interface{}{},
+		"message":    "success",
+	})
+}
+
+// addHandler adds the handler. It takes mu because the server goroutine reads the handlers.
+func (s *SwitchBotMock) addHandler(handler *HttpMockHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers = append(s.handlers, handler)
 }
 
 // RegisterDevicesMock registers a mock response for the device's endpoint.
 func (s *SwitchBotMock) RegisterDevicesMock(devices []interface{}, infraredDevices []interface{}) {
-	s.handlers = append(s.handlers, &HttpMockHandler{
+	s.addHandler(&HttpMockHandler{
 		Method: http.MethodGet,
 		Path:   "/devices",
 		Count:  0,
@@ -61,23 +109,14 @@ func (s *SwitchBotMock) RegisterDevicesMock(devices []interface{}, infraredDevic
 					InfraredRemoteList: infraredDevices,
 				},
 			}
-			responseJsonText, err := json.Marshal(response)
-			if err != nil {
-				s.t.Fatalf("Failed to marshal response: %v", err)
-			}
-			w.WriteHeader(http.StatusOK)
-			w.Header().Set("Content-Type", "application/json")
-			_, err = w.Write(responseJsonText)
-			if err != nil {
-				s.t.Fatalf("Failed to write response: %v", err)
-			}
+			s.writeResponse(w, response)
 		},
 	})
 }
 
 // RegisterStatusMock registers a mock response for a specific device's status.
 func (s *SwitchBotMock) RegisterStatusMock(deviceId string, mockBody interface{}) {
-	s.handlers = append(s.handlers, &HttpMockHandler{
+	s.addHandler(&HttpMockHandler{
 		Method: http.MethodGet,
 		Path:   "/devices/" + deviceId + "/status",
 		Count:  0,
@@ -92,56 +131,162 @@ func (s *SwitchBotMock) RegisterStatusMock(deviceId string, mockBody interface{}
 				},
 				Body: mockBody,
 			}
-			responseJsonText, err := json.Marshal(response)
-			if err != nil {
-				s.t.Fatalf("Failed to marshal response: %v", err)
-			}
-			w.WriteHeader(http.StatusOK)
-			w.Header().Set("Content-Type", "application/json")
-			_, err = w.Write(responseJsonText)
-			if err != nil {
-				s.t.Fatalf("Failed to write response: %v", err)
-			}
+			s.writeResponse(w, response)
 		},
 	})
 }
 
 // RegisterCommandMock registers a mock response for a specific device's command.
 func (s *SwitchBotMock) RegisterCommandMock(deviceId string, expectedBody string) {
-	s.handlers = append(s.handlers, &HttpMockHandler{
+	s.registerExpectedBodyMock("/devices/"+deviceId+"/commands", expectedBody)
+}
+
+// RegisterScenesMock registers a mock response for the scene's endpoint.
+func (s *SwitchBotMock) RegisterScenesMock(scenes []interface{}) {
+	s.addHandler(&HttpMockHandler{
+		Method: http.MethodGet,
+		Path:   "/scenes",
+		Count:  0,
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			response := struct {
+				switchbot.CommonResponse
+				Body []interface{} `json:"body"`
+			}{
+				CommonResponse: switchbot.CommonResponse{
+					StatusCode: 100,
+					Message:    "success",
+				},
+				Body: scenes,
+			}
+			s.writeResponse(w, response)
+		},
+	})
+}
+
+// RegisterSceneExecuteMock registers a mock response for executing a specific scene.
+func (s *SwitchBotMock) RegisterSceneExecuteMock(sceneId string) {
+	s.addHandler(&HttpMockHandler{
 		Method: http.MethodPost,
-		Path:   "/devices/" + deviceId + "/commands",
+		Path:   "/scenes/" + sceneId + "/execute",
 		Count:  0,
 		Handler: func(w http.ResponseWriter, r *http.Request) {
-			var expectedObject map[string]interface{}
-			if err := json.Unmarshal([]byte(expectedBody), &expectedObject); err != nil {
-				s.t.Fatalf("Failed to unmarshal expected body: %v", err)
+			s.writeSuccessResponse(w)
+		},
+	})
+}
+
+// RegisterSetupWebhookMock registers a mock response for the webhook setup endpoint.
+func (s *SwitchBotMock) RegisterSetupWebhookMock(expectedBody string) {
+	s.registerExpectedBodyMock("/webhook/setupWebhook", expectedBody)
+}
+
+// RegisterQueryWebhookMock registers a mock response for the webhook query endpoint.
+// The "queryUrl" action returns the urls, and the "queryDetails" action returns the details whose url is requested.
+func (s *SwitchBotMock) RegisterQueryWebhookMock(urls []string, details []switchbot.WebhookDetail) {
+	s.addHandler(&HttpMockHandler{
+		Method: http.MethodPost,
+		Path:   "/webhook/queryWebhook",
+		Count:  0,
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var request switchbot.QueryWebhookRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				s.t.Errorf("Failed to decode actual body: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
 			}
 
-			var actualObject map[string]interface{}
-			if err := json.NewDecoder(r.Body).Decode(&actualObject); err != nil {
-				s.t.Fatalf("Failed to decode actual body: %v", err)
+			var body interface{}
+			switch request.Action {
+			case "queryUrl":
+				body = switchbot.QueryWebhookURLResponseBody{URLs: urls}
+			case "queryDetails":
+				matched := []switchbot.WebhookDetail{}
+				for _, detail := range details {
+					for _, url := range request.URLs {
+						if detail.URL == url {
+							matched = append(matched, detail)
+						}
+					}
+				}
+				body = matched
+			default:
+				s.t.Errorf("Unexpected action: %s", request.Action)
+				w.WriteHeader(http.StatusBadRequest)
+				return
 			}
-			if !reflect.DeepEqual(expectedObject, actualObject) {
-				s.t.Fatalf("Expected body %v, got %v", expectedObject, actualObject)
+
+			response := struct {
+				switchbot.CommonResponse
+				Body interface{} `json:"body"`
+			}{
+				CommonResponse: switchbot.CommonResponse{
+					StatusCode: 100,
+					Message:    "success",
+				},
+				Body: body,
 			}
+			s.writeResponse(w, response)
+		},
+	})
+}
 
-			w.WriteHeader(http.StatusOK)
-			w.Header().Set("Content-Type", "application/json")
-			_, err := w.Write([]byte(`{
-    "statusCode": 100,
-	"body": {},
-    "message": "success"
-}`))
+// RegisterUpdateWebhookMock registers a mock response for the webhook update endpoint.
+func (s *SwitchBotMock) RegisterUpdateWebhookMock(expectedBody string) {
+	s.registerExpectedBodyMock("/webhook/updateWebhook", expectedBody)
+}
+
+// RegisterDeleteWebhookMock registers a mock response for the webhook delete endpoint.
+func (s *SwitchBotMock) RegisterDeleteWebhookMock(expectedBody string) {
+	s.registerExpectedBodyMock("/webhook/deleteWebhook", expectedBody)
+}
+
+// registerExpectedBodyMock registers a POST mock that checks the request body and returns a success response.
+func (s *SwitchBotMock) registerExpectedBodyMock(path string, expectedBody string) {
+	s.addHandler(&HttpMockHandler{
+		Method: http.MethodPost,
+		Path:   path,
+		Count:  0,
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			actualBody, err := io.ReadAll(r.Body)
 			if err != nil {
-				s.t.Fatalf("Failed to write response: %v", err)
+				s.t.Errorf("Failed to read actual body: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if !s.jsonEqual(expectedBody, actualBody) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
 			}
+
+			s.writeSuccessResponse(w)
 		},
 	})
 }
 
+// jsonEqual reports whether the actual body is the same JSON as the expected body, reporting a mismatch with t.Errorf.
+func (s *SwitchBotMock) jsonEqual(expectedBody string, actualBody []byte) bool {
+	var expectedObject interface{}
+	if err := json.Unmarshal([]byte(expectedBody), &expectedObject); err != nil {
+		s.t.Errorf("Failed to unmarshal expected body: %v", err)
+		return false
+	}
+
+	var actualObject interface{}
+	if err := json.Unmarshal(actualBody, &actualObject); err != nil {
+		s.t.Errorf("Failed to decode actual body: %v", err)
+		return false
+	}
+	if !reflect.DeepEqual(expectedObject, actualObject) {
+		s.t.Errorf("Expected body %v, got %v", expectedObject, actualObject)
+		return false
+	}
+	return true
+}
+
 // AssertCallCount checks the number of times a specific method and path were called.
 func (s *SwitchBotMock) AssertCallCount(method string, path string, expected int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	for _, handler := range s.handlers {
 		if handler.IsMatch(method, path) {
 			if handler.Count != expected {
@@ -153,18 +298,76 @@ func (s *SwitchBotMock) AssertCallCount(method string, path string, expected int
 	s.t.Fatalf("No handler found for %s %s", method, path)
 }
 
+// Requests returns the requests received by the mock, in order.
+func (s *SwitchBotMock) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedRequest{}, s.requests...)
+}
+
+// ExpectedRequest is a request expected by AssertRequestOrder.
+type ExpectedRequest struct {
+	Method string
+	Path   string
+	// Body is the expected JSON body. It is not checked if empty.
+	Body string
+}
+
+// AssertRequestOrder checks that the mock received exactly the expected requests in order.
+func (s *SwitchBotMock) AssertRequestOrder(expected ...ExpectedRequest) {
+	requests := s.Requests()
+	if len(requests) != len(expected) {
+		s.t.Errorf("Expected %d requests, got %d", len(expected), len(requests))
+	}
+	for i := 0; i < len(requests) && i < len(expected); i++ {
+		actual := requests[i]
+		if actual.Method != expected[i].Method || actual.Path != expected[i].Path {
+			s.t.Errorf("Expected request #%d to be %s %s, got %s %s", i+1, expected[i].Method, expected[i].Path, actual.Method, actual.Path)
+			continue
+		}
+		if expected[i].Body != "" {
+			s.jsonEqual(expected[i].Body, actual.Body)
+		}
+	}
+}
+
 // NewTestServer creates a new test server with the mock handlers.
 func (s *SwitchBotMock) NewTestServer() *httptest.Server {
 	return httptest.NewServer(
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				s.t.Errorf("Failed to read request body: %v", err)
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			s.mu.Lock()
+			s.requests = append(s.requests, RecordedRequest{
+				Method: r.Method,
+				Path:   r.URL.Path,
+				Header: r.Header.Clone(),
+				Body:   body,
+			})
+			var matched *HttpMockHandler
 			for _, handler := range s.handlers {
 				if handler.IsMatch(r.Method, r.URL.Path) {
 					handler.Count++
-					handler.Handler(w, r)
-					return
+					matched = handler
+					break
 				}
 			}
-			s.t.Fatalf("No handler found for %s %s", r.Method, r.URL.Path)
+			fault := s.nextFault(r.Method, r.URL.Path)
+			s.mu.Unlock()
+
+			if fault != nil && fault.apply(w) {
+				return
+			}
+			if matched == nil {
+				s.t.Errorf("No handler found for %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			matched.Handler(w, r)
 		}),
 	)
 }
@@ -0,0 +1,109 @@
+package helpers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Fault is a failure injected into a response of the SwitchBotMock.
+// A zero Fault does not change the response, which is used to let a call in a sequence succeed.
+type Fault struct {
+	// Latency delays the response
+	Latency time.Duration
+	// HTTPStatusCode responds with the HTTP status, such as 401, 429 or 500
+	HTTPStatusCode int
+	// StatusCode responds with HTTP 200 and the statusCode, such as 161, 171 or 190
+	StatusCode int
+	// RetryAfter sets the Retry-After header in seconds when HTTPStatusCode is set
+	RetryAfter int
+	// MalformedJSON responds with HTTP 200 and a body that is not valid JSON
+	MalformedJSON bool
+}
+
+// FaultHTTPStatus returns a Fault that responds with the HTTP status
+func FaultHTTPStatus(httpStatusCode int) Fault {
+	return Fault{HTTPStatusCode: httpStatusCode}
+}
+
+// FaultStatusCode returns a Fault that responds with HTTP 200 and the statusCode
+func FaultStatusCode(statusCode int) Fault {
+	return Fault{StatusCode: statusCode}
+}
+
+// FaultLatency returns a Fault that delays the normal response
+func FaultLatency(latency time.Duration) Fault {
+	return Fault{Latency: latency}
+}
+
+// FaultMalformedJSON returns a Fault that responds with a body that is not valid JSON
+func FaultMalformedJSON() Fault {
+	return Fault{MalformedJSON: true}
+}
+
+// apply writes the faulty response. It returns false if the normal response should be written instead.
+func (fault *Fault) apply(w http.ResponseWriter) bool {
+	if fault.Latency > 0 {
+		time.Sleep(fault.Latency)
+	}
+
+	switch {
+	case fault.HTTPStatusCode != 0:
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(fault.RetryAfter))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(fault.HTTPStatusCode)
+		_, _ = w.Write([]byte(`{"message":"` + http.StatusText(fault.HTTPStatusCode) + `"}`))
+		return true
+	case fault.StatusCode != 0:
+		body, _ := json.Marshal(map[string]interface{}{
+			"statusCode": fault.StatusCode,
+			"body":       map[string]interface{}{},
+			"message":    "injected fault",
+		})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+		return true
+	case fault.MalformedJSON:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"statusCode":100,"body":`))
+		return true
+	}
+	return false
+}
+
+// faultSequence is the list of faults injected into the calls to an endpoint
+type faultSequence struct {
+	faults []Fault
+	next   int
+}
+
+// InjectFaults makes the next calls to the method and path fail with the faults, one fault per call in order.
+// The calls after the faults are used up get the normal response. For example,
+// InjectFaults(http.MethodGet, "/devices", FaultHTTPStatus(500), FaultHTTPStatus(500)) fails twice and then succeeds.
+func (s *SwitchBotMock) InjectFaults(method string, path string, faults ...Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := method + " " + path
+	sequence, ok := s.faults[key]
+	if !ok {
+		sequence = &faultSequence{}
+		s.faults[key] = sequence
+	}
+	sequence.faults = append(sequence.faults, faults...)
+}
+
+// nextFault returns the fault for the next call to the method and path, or nil. It must be called with mu held.
+func (s *SwitchBotMock) nextFault(method string, path string) *Fault {
+	sequence, ok := s.faults[method+" "+path]
+	if !ok || sequence.next >= len(sequence.faults) {
+		return nil
+	}
+	fault := sequence.faults[sequence.next]
+	sequence.next++
+	return &fault
+}
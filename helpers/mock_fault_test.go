@@ -0,0 +1,144 @@
+package helpers_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestSwitchBotMockFaults(t *testing.T) {
+	newMock := func(t *testing.T, options ...switchbot.Option) (*helpers.SwitchBotMock, *switchbot.Client, func()) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterScenesMock([]interface{}{
+			map[string]interface{}{"sceneId": "scene-1", "sceneName": "Good Morning"},
+		})
+		switchBotMock.RegisterCommandMock("ABCDEF123456", `{"commandType":"command","command":"turnOn","parameter":"default"}`)
+		testServer := switchBotMock.NewTestServer()
+
+		policy := switchbot.DefaultRetryPolicy()
+		policy.InitialBackoff = time.Millisecond
+		policy.MaxBackoff = time.Millisecond
+		options = append([]switchbot.Option{switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(policy)}, options...)
+		client := switchbot.NewClient("secret", "token", options...)
+		return switchBotMock, client, testServer.Close
+	}
+
+	t.Run("FailTwiceThenSucceed", func(t *testing.T) {
+		switchBotMock, client, closeServer := newMock(t)
+		defer closeServer()
+		switchBotMock.InjectFaults(http.MethodGet, "/scenes", helpers.FaultHTTPStatus(http.StatusInternalServerError), helpers.FaultHTTPStatus(http.StatusTooManyRequests))
+
+		scenes, err := client.GetScenes()
+		assert.NoError(t, err)
+		assert.Len(t, scenes.Body, 1)
+		switchBotMock.AssertCallCount(http.MethodGet, "/scenes", 3)
+	})
+
+	t.Run("StatusCodes", func(t *testing.T) {
+		testDataList := []struct {
+			fault    helpers.Fault
+			expected error
+		}{
+			{fault: helpers.FaultHTTPStatus(http.StatusUnauthorized), expected: switchbot.ErrUnauthorized},
+			{fault: helpers.FaultStatusCode(switchbot.StatusCodeDeviceOffline), expected: switchbot.ErrDeviceOffline},
+			{fault: helpers.FaultStatusCode(switchbot.StatusCodeHubOffline), expected: switchbot.ErrHubOffline},
+			{fault: helpers.FaultStatusCode(switchbot.StatusCodeDeviceInternalError), expected: switchbot.ErrDeviceInternalError},
+		}
+		for _, testData := range testDataList {
+			// MEMO: Disable retries so that the injected fault is returned as it is.
+			switchBotMock, client, closeServer := newMock(t, switchbot.OptionRetryPolicy(switchbot.RetryPolicy{}))
+			switchBotMock.InjectFaults(http.MethodPost, "/devices/ABCDEF123456/commands", testData.fault)
+
+			_, err := client.SendCommand("ABCDEF123456", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+			assert.ErrorIs(t, err, testData.expected)
+			closeServer()
+		}
+	})
+
+	t.Run("MalformedJSON", func(t *testing.T) {
+		switchBotMock, client, closeServer := newMock(t)
+		defer closeServer()
+		switchBotMock.InjectFaults(http.MethodGet, "/scenes", helpers.FaultMalformedJSON())
+
+		_, err := client.GetScenes()
+		assert.Error(t, err)
+	})
+
+	t.Run("Latency", func(t *testing.T) {
+		switchBotMock, client, closeServer := newMock(t)
+		defer closeServer()
+		switchBotMock.InjectFaults(http.MethodGet, "/scenes", helpers.FaultLatency(200*time.Millisecond))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := client.GetScenesContext(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("RequestOrder", func(t *testing.T) {
+		switchBotMock, client, closeServer := newMock(t)
+		defer closeServer()
+
+		_, err := client.GetScenes()
+		assert.NoError(t, err)
+		_, err = client.SendCommand("ABCDEF123456", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+		assert.NoError(t, err)
+
+		switchBotMock.AssertRequestOrder(
+			helpers.ExpectedRequest{Method: http.MethodGet, Path: "/scenes"},
+			helpers.ExpectedRequest{Method: http.MethodPost, Path: "/devices/ABCDEF123456/commands", Body: `{"commandType":"command","command":"turnOn","parameter":"default"}`},
+		)
+		requests := switchBotMock.Requests()
+		assert.Equal(t, "token", requests[1].Header.Get("Authorization"))
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		switchBotMock, client, closeServer := newMock(t)
+		defer closeServer()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := client.GetScenes()
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		switchBotMock.AssertCallCount(http.MethodGet, "/scenes", 10)
+		assert.Len(t, switchBotMock.Requests(), 10)
+	})
+
+	t.Run("RegisterWhileServing", func(t *testing.T) {
+		switchBotMock, client, closeServer := newMock(t)
+		defer closeServer()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_, err := client.GetScenes()
+				assert.NoError(t, err)
+			}()
+			go func() {
+				defer wg.Done()
+				switchBotMock.RegisterSceneExecuteMock("scene-1")
+			}()
+		}
+		wg.Wait()
+
+		scenes, err := client.GetScenes()
+		assert.NoError(t, err)
+		_, err = scenes.Body[0].Execute()
+		assert.NoError(t, err)
+		switchBotMock.AssertCallCount(http.MethodPost, "/scenes/scene-1/execute", 1)
+	})
+}
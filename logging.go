@@ -0,0 +1,159 @@
+package switchbot
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RedactedValue replaces secrets such as the token, the signature and keypad passwords in the logs
+const RedactedValue = "[REDACTED]"
+
+// redactedHeaders is the set of request headers whose values are replaced with RedactedValue
+var redactedHeaders = map[string]bool{
+	"Authorization": true,
+	"Sign":          true,
+}
+
+// redactedFields is the set of JSON fields (in lower case) whose values are replaced with RedactedValue
+var redactedFields = map[string]bool{
+	"password": true,
+	"token":    true,
+	"secret":   true,
+	"sign":     true,
+}
+
+// OptionLogger sets the logger that records every request sent by the Client.
+// Successful requests are logged at the debug level and failed requests at the warn level.
+// Headers and bodies are only logged when the debug level is enabled, with secrets replaced by RedactedValue.
+func OptionLogger(logger *slog.Logger) func(*Client) {
+	return func(client *Client) {
+		client.logger = logger
+	}
+}
+
+// requestLog holds the information about a single attempt to be logged
+type requestLog struct {
+	request      *http.Request
+	response     *http.Response
+	requestBody  []byte
+	responseBody []byte
+	path         string
+	attempt      int
+	duration     time.Duration
+	err          error
+}
+
+// logRequest writes a structured record for a single attempt
+func (client *Client) logRequest(ctx context.Context, entry requestLog) {
+	level := slog.LevelDebug
+	message := "switchbot request succeeded"
+	if entry.err != nil {
+		level = slog.LevelWarn
+		message = "switchbot request failed"
+	}
+	if !client.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("path", entry.path),
+		slog.Int("attempt", entry.attempt),
+		slog.Duration("duration", entry.duration),
+	}
+	if entry.request != nil {
+		attrs = append(attrs, slog.String("method", entry.request.Method))
+	}
+	if deviceID := deviceIDFromPath(entry.path); deviceID != "" {
+		attrs = append(attrs, slog.String("deviceId", deviceID))
+	}
+	if strings.HasSuffix(entry.path, "/commands") {
+		request := ControlRequest{}
+		if json.Unmarshal(entry.requestBody, &request) == nil {
+			attrs = append(attrs, slog.String("command", request.Command))
+		}
+	}
+	if entry.response != nil {
+		attrs = append(attrs, slog.Int("httpStatusCode", entry.response.StatusCode))
+	}
+	if statusCode, ok := statusCodeFromBody(entry.responseBody); ok {
+		attrs = append(attrs, slog.Int("statusCode", statusCode))
+	}
+	if entry.err != nil {
+		attrs = append(attrs, slog.String("error", entry.err.Error()))
+	}
+
+	if client.logger.Enabled(ctx, slog.LevelDebug) {
+		if entry.request != nil {
+			attrs = append(attrs, slog.Any("requestHeaders", redactHeader(entry.request.Header)))
+		}
+		if len(entry.requestBody) > 0 {
+			attrs = append(attrs, slog.String("requestBody", RedactBody(entry.requestBody)))
+		}
+		if len(entry.responseBody) > 0 {
+			attrs = append(attrs, slog.String("responseBody", RedactBody(entry.responseBody)))
+		}
+	}
+
+	client.logger.LogAttrs(ctx, level, message, attrs...)
+}
+
+// statusCodeFromBody returns the statusCode in the response body if present
+func statusCodeFromBody(body []byte) (int, bool) {
+	response := struct {
+		StatusCode *int `json:"statusCode"`
+	}{}
+	if json.Unmarshal(body, &response) != nil || response.StatusCode == nil {
+		return 0, false
+	}
+	return *response.StatusCode, true
+}
+
+// redactHeader returns a copy of the header with the values of redactedHeaders replaced
+func redactHeader(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for key := range header {
+		if redactedHeaders[http.CanonicalHeaderKey(key)] {
+			redacted[key] = RedactedValue
+			continue
+		}
+		redacted[key] = header.Get(key)
+	}
+	return redacted
+}
+
+// RedactBody returns the JSON body with the values of secret fields, such as password and token, replaced with RedactedValue.
+// A body that is not valid JSON is returned as is.
+func RedactBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			if redactedFields[strings.ToLower(key)] {
+				typed[key] = RedactedValue
+				continue
+			}
+			typed[key] = redactValue(child)
+		}
+	case []interface{}:
+		for i, child := range typed {
+			typed[i] = redactValue(child)
+		}
+	}
+	return value
+}
@@ -0,0 +1,116 @@
+package switchbot_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+// decodeLogRecords parses the output of slog.JSONHandler into records
+func decodeLogRecords(t *testing.T, output *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		record := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestOptionLogger(t *testing.T) {
+	t.Run("CommandWithRedaction", func(t *testing.T) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterCommandMock("ABCDEF123456", `{"commandType":"command","command":"createKey","parameter":{"name":"key","type":"permanent","password":"12345678"}}`)
+		testServer := switchBotMock.NewTestServer()
+		defer testServer.Close()
+
+		output := &bytes.Buffer{}
+		logger := slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionLogger(logger))
+
+		keypadKey, err := switchbot.NewKeypadKey("key", "permanent", "12345678", 0, 0)
+		assert.NoError(t, err)
+		device := &switchbot.KeypadDevice{
+			CommonDeviceListItem: switchbot.CommonDeviceListItem{
+				CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"},
+				Client:       client,
+			},
+		}
+		_, err = device.CreateKey(keypadKey)
+		assert.NoError(t, err)
+
+		assert.NotContains(t, output.String(), "12345678")
+		assert.NotContains(t, output.String(), `"token"`)
+
+		records := decodeLogRecords(t, output)
+		assert.Len(t, records, 1)
+		record := records[0]
+		assert.Equal(t, "DEBUG", record["level"])
+		assert.Equal(t, "POST", record["method"])
+		assert.Equal(t, "/devices/ABCDEF123456/commands", record["path"])
+		assert.Equal(t, "ABCDEF123456", record["deviceId"])
+		assert.Equal(t, "createKey", record["command"])
+		assert.Equal(t, float64(100), record["statusCode"])
+		assert.Equal(t, float64(200), record["httpStatusCode"])
+		assert.Equal(t, float64(1), record["attempt"])
+		assert.Contains(t, record, "duration")
+
+		headers := record["requestHeaders"].(map[string]interface{})
+		assert.Equal(t, switchbot.RedactedValue, headers["Authorization"])
+		assert.Equal(t, switchbot.RedactedValue, headers["Sign"])
+		assert.NotEmpty(t, headers["Nonce"])
+		assert.Contains(t, record["requestBody"], `"password":"`+switchbot.RedactedValue+`"`)
+	})
+
+	t.Run("FailedRequestAtInfoLevel", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"statusCode":161,"body":{},"message":"device offline"}`))
+		}))
+		defer testServer.Close()
+
+		output := &bytes.Buffer{}
+		logger := slog.New(slog.NewJSONHandler(output, nil))
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionLogger(logger))
+
+		_, err := client.SendCommand("ABCDEF123456", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+		assert.ErrorIs(t, err, switchbot.ErrDeviceOffline)
+
+		records := decodeLogRecords(t, output)
+		assert.Len(t, records, 1)
+		record := records[0]
+		assert.Equal(t, "WARN", record["level"])
+		assert.Equal(t, "turnOn", record["command"])
+		assert.Equal(t, float64(161), record["statusCode"])
+		assert.Contains(t, record["error"], "device offline")
+		assert.NotContains(t, record, "requestHeaders", "headers are only logged at the debug level")
+		assert.NotContains(t, record, "responseBody", "bodies are only logged at the debug level")
+	})
+
+	t.Run("SuccessNotLoggedAtInfoLevel", func(t *testing.T) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterScenesMock([]interface{}{})
+		testServer := switchBotMock.NewTestServer()
+		defer testServer.Close()
+
+		output := &bytes.Buffer{}
+		logger := slog.New(slog.NewJSONHandler(output, nil))
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionLogger(logger))
+
+		_, err := client.GetScenes()
+		assert.NoError(t, err)
+		assert.Empty(t, output.String())
+	})
+}
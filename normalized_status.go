@@ -0,0 +1,388 @@
+package switchbot
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PowerState is the normalized power state of a device
+type PowerState string
+
+const (
+	PowerStateUnknown PowerState = ""
+	PowerStateOn      PowerState = "on"
+	PowerStateOff     PowerState = "off"
+)
+
+// LockState is the normalized state of a lock
+type LockState string
+
+const (
+	LockStateUnknown  LockState = ""
+	LockStateLocked   LockState = "locked"
+	LockStateUnlocked LockState = "unlocked"
+	LockStateJammed   LockState = "jammed"
+)
+
+// DoorState is the normalized state of a door, such as the door of a lock, a contact sensor or a garage door
+type DoorState string
+
+const (
+	DoorStateUnknown DoorState = ""
+	DoorStateOpen    DoorState = "open"
+	DoorStateClosed  DoorState = "closed"
+)
+
+// Percent is a value from 0 to 100
+type Percent int
+
+// Celsius is a temperature in degrees Celsius
+type Celsius float64
+
+// Watts is a power consumption in watts
+type Watts float64
+
+// NormalizedStatus is a device status with typed values that can be handled in the same way for all devices.
+// The enums are unknown and the pointers are nil if the device does not report the value.
+type NormalizedStatus struct {
+	DeviceID   string     `json:"deviceId"`
+	DeviceType string     `json:"deviceType"`
+	Power      PowerState `json:"power,omitempty"`
+	Lock       LockState  `json:"lock,omitempty"`
+	Door       DoorState  `json:"door,omitempty"`
+	// Position is the position of a curtain, blind tilt or roller shade in the same convention as Positionable,
+	// where 0 is fully open and 100 is fully closed, whatever the device reports
+	Position    *Percent `json:"position,omitempty"`
+	Brightness  *Percent `json:"brightness,omitempty"`
+	Temperature *Celsius `json:"temperature,omitempty"`
+	Humidity    *Percent `json:"humidity,omitempty"`
+	Watts       *Watts   `json:"watts,omitempty"`
+	Battery     *Percent `json:"battery,omitempty"`
+	// LeakDetected is reported by the water leak detector
+	LeakDetected *bool `json:"leakDetected,omitempty"`
+}
+
+// Normalizer is implemented by all status bodies
+type Normalizer interface {
+	Normalize() NormalizedStatus
+}
+
+// NormalizeStatus converts a status body, such as the one returned by GetAnyStatusBody, to NormalizedStatus.
+// It returns false if the status body does not implement Normalizer.
+func NormalizeStatus(body any) (NormalizedStatus, bool) {
+	normalizer, ok := body.(Normalizer)
+	if !ok {
+		return NormalizedStatus{}, false
+	}
+	return normalizer.Normalize(), true
+}
+
+func newNormalizedStatus(device CommonDevice) NormalizedStatus {
+	return NormalizedStatus{DeviceID: device.DeviceID, DeviceType: device.DeviceType}
+}
+
+func pointerOf[T any](value T) *T {
+	return &value
+}
+
+// normalizePower converts "on" / "off" to PowerState
+func normalizePower(power string) PowerState {
+	switch strings.ToLower(power) {
+	case "on":
+		return PowerStateOn
+	case "off":
+		return PowerStateOff
+	}
+	return PowerStateUnknown
+}
+
+// normalizeSwitchStatus converts the switchStatus of relay switches (1: on, 0: off) to PowerState
+func normalizeSwitchStatus(switchStatus int) PowerState {
+	if switchStatus == 1 {
+		return PowerStateOn
+	}
+	return PowerStateOff
+}
+
+// normalizeLockState converts "locked" / "unlocked" / "jammed" to LockState
+func normalizeLockState(lockState string) LockState {
+	switch strings.ToLower(lockState) {
+	case "locked":
+		return LockStateLocked
+	case "unlocked":
+		return LockStateUnlocked
+	case "jammed":
+		return LockStateJammed
+	}
+	return LockStateUnknown
+}
+
+// normalizeDoorState converts the doorState of locks and the openState of contact sensors to DoorState
+func normalizeDoorState(doorState string) DoorState {
+	switch strings.ToLower(doorState) {
+	case "open", "opened", "timeoutnotclose":
+		return DoorStateOpen
+	case "close", "closed":
+		return DoorStateClosed
+	}
+	return DoorStateUnknown
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *BotDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *CurtainDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	// MEMO: The slidePosition of Curtain is 0 when open and 100 when closed, which is already the convention of Position.
+	if position, err := strconv.Atoi(body.SlidePosition); err == nil {
+		status.Position = pointerOf(Percent(position))
+	}
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *Hub2DeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Temperature = pointerOf(Celsius(body.Temperature))
+	status.Humidity = pointerOf(Percent(body.Humidity))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *Hub3DeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Temperature = pointerOf(Celsius(body.Temperature))
+	status.Humidity = pointerOf(Percent(body.Humidity))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *MeterDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Temperature = pointerOf(Celsius(body.Temperature))
+	status.Humidity = pointerOf(Percent(body.Humidity))
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *MeterProCo2DeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Temperature = pointerOf(Celsius(body.Temperature))
+	status.Humidity = pointerOf(Percent(body.Humidity))
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *LockDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Lock = normalizeLockState(body.LockState)
+	status.Door = normalizeDoorState(body.DoorState)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *LockLiteDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Lock = normalizeLockState(body.LockState)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *KeypadDeviceStatusBody) Normalize() NormalizedStatus {
+	return newNormalizedStatus(body.CommonDevice)
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *MotionSensorDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *ContactSensorDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Door = normalizeDoorState(body.OpenState)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *WaterLeakDetectorDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.LeakDetected = pointerOf(body.Status)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *CeilingLightDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	status.Brightness = pointerOf(Percent(body.Brightness))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *PlugMiniDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	// MEMO: Watts is left empty. The API documents "weight" as the power consumed in a day,
+	// so it is not the current power consumption that Watts represents.
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *PlugDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *StripLightDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	status.Brightness = pointerOf(Percent(body.Brightness))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *ColorLightDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	status.Brightness = pointerOf(Percent(body.Brightness))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *RobotVacuumCleanerDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *RobotVacuumCleanerSDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *RobotVacuumCleanerComboDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *HumidifierDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	status.Temperature = pointerOf(Celsius(body.Temperature))
+	status.Humidity = pointerOf(Percent(body.Humidity))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *EvaporativeHumidifierDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	status.Humidity = pointerOf(Percent(body.Humidity))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *AirPurifierDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *BlindTiltDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	// MEMO: The slidePosition of Blind Tilt is 0 when closed and 100 when open, which is the opposite of Position.
+	status.Position = pointerOf(Percent(100 - body.SlidePosition))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *BatteryCirculatorFanDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *CirculatorFanDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizePower(body.Power)
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *RollerShadeDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	// MEMO: The slidePosition of Roller Shade is 0 when open and 100 when closed, the same as its setPosition command and Position.
+	status.Position = pointerOf(Percent(body.SlidePosition))
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *RelaySwitch1PMDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizeSwitchStatus(body.SwitchStatus)
+	status.Watts = pointerOf(Watts(body.Power))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *RelaySwitch1DeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Power = normalizeSwitchStatus(body.SwitchStatus)
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *RelaySwitch2PMDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	// MEMO: The two channels are combined: the power is on if either channel is on, and the watts are the sum of both.
+	// Position is left empty because the status body does not report the position of the roller shade mode.
+	status.Power = normalizeSwitchStatus(max(body.Switch1Status, body.Switch2Status))
+	status.Watts = pointerOf(Watts(body.Switch1Power + body.Switch2Power))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *VideoDoorbellDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	status.Battery = pointerOf(Percent(body.Battery))
+	return status
+}
+
+// Normalize converts the status to NormalizedStatus
+func (body *GarageDoorOpenerDeviceStatusBody) Normalize() NormalizedStatus {
+	status := newNormalizedStatus(body.CommonDevice)
+	// MEMO: doorStatus is 0 when the door is open and 1 when it is closed.
+	switch body.DoorStatus {
+	case 0:
+		status.Door = DoorStateOpen
+	case 1:
+		status.Door = DoorStateClosed
+	}
+	return status
+}
@@ -0,0 +1,125 @@
+package switchbot_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+)
+
+func TestNormalizeStatus(t *testing.T) {
+	percent := func(value int) *switchbot.Percent {
+		p := switchbot.Percent(value)
+		return &p
+	}
+	celsius := func(value float64) *switchbot.Celsius {
+		c := switchbot.Celsius(value)
+		return &c
+	}
+	watts := func(value float64) *switchbot.Watts {
+		w := switchbot.Watts(value)
+		return &w
+	}
+	leak := true
+	common := switchbot.CommonDevice{DeviceID: "ABCDEF123456", DeviceType: "Type"}
+
+	testDataList := []struct {
+		name     string
+		body     any
+		expected switchbot.NormalizedStatus
+	}{
+		{
+			name:     "Bot",
+			body:     &switchbot.BotDeviceStatusBody{CommonDevice: common, Power: "on", Battery: 90},
+			expected: switchbot.NormalizedStatus{Power: switchbot.PowerStateOn, Battery: percent(90)},
+		},
+		{
+			name:     "CurtainWithStringPosition",
+			body:     &switchbot.CurtainDeviceStatusBody{CommonDevice: common, SlidePosition: "30", Battery: 80},
+			expected: switchbot.NormalizedStatus{Position: percent(30), Battery: percent(80)},
+		},
+		{
+			name:     "BlindTiltOpen",
+			body:     &switchbot.BlindTiltDeviceStatusBody{CommonDevice: common, Direction: "up", SlidePosition: 100},
+			expected: switchbot.NormalizedStatus{Position: percent(0)},
+		},
+		{
+			name:     "BlindTiltMostlyClosed",
+			body:     &switchbot.BlindTiltDeviceStatusBody{CommonDevice: common, Direction: "down", SlidePosition: 30},
+			expected: switchbot.NormalizedStatus{Position: percent(70)},
+		},
+		{
+			name:     "RollerShade",
+			body:     &switchbot.RollerShadeDeviceStatusBody{CommonDevice: common, SlidePosition: 30, Battery: 80},
+			expected: switchbot.NormalizedStatus{Position: percent(30), Battery: percent(80)},
+		},
+		{
+			name:     "PlugMiniWithoutWatts",
+			body:     &switchbot.PlugMiniDeviceStatusBody{CommonDevice: common, Voltage: 100, Weight: 120, ElectricCurrent: 1.2},
+			expected: switchbot.NormalizedStatus{},
+		},
+		{
+			name:     "Meter",
+			body:     &switchbot.MeterDeviceStatusBody{CommonDevice: common, Temperature: 22.5, Humidity: 40, Battery: 100},
+			expected: switchbot.NormalizedStatus{Temperature: celsius(22.5), Humidity: percent(40), Battery: percent(100)},
+		},
+		{
+			name:     "Lock",
+			body:     &switchbot.LockDeviceStatusBody{CommonDevice: common, LockState: "jammed", DoorState: "opened", Battery: 50},
+			expected: switchbot.NormalizedStatus{Lock: switchbot.LockStateJammed, Door: switchbot.DoorStateOpen, Battery: percent(50)},
+		},
+		{
+			name:     "ContactSensor",
+			body:     &switchbot.ContactSensorDeviceStatusBody{CommonDevice: common, OpenState: "close", Battery: 70},
+			expected: switchbot.NormalizedStatus{Door: switchbot.DoorStateClosed, Battery: percent(70)},
+		},
+		{
+			name:     "WaterLeakDetector",
+			body:     &switchbot.WaterLeakDetectorDeviceStatusBody{CommonDevice: common, Status: true, Battery: 60},
+			expected: switchbot.NormalizedStatus{LeakDetected: &leak, Battery: percent(60)},
+		},
+		{
+			name:     "PlugOff",
+			body:     &switchbot.PlugDeviceStatusBody{CommonDevice: common, Power: "off"},
+			expected: switchbot.NormalizedStatus{Power: switchbot.PowerStateOff},
+		},
+		{
+			name:     "RelaySwitch2PM",
+			body:     &switchbot.RelaySwitch2PMDeviceStatusBody{CommonDevice: common, Switch1Status: 0, Switch2Status: 1, Switch1Power: 3, Switch2Power: 12},
+			expected: switchbot.NormalizedStatus{Power: switchbot.PowerStateOn, Watts: watts(15)},
+		},
+		{
+			name:     "GarageDoorOpener",
+			body:     &switchbot.GarageDoorOpenerDeviceStatusBody{CommonDevice: common, DoorStatus: 1},
+			expected: switchbot.NormalizedStatus{Door: switchbot.DoorStateClosed},
+		},
+	}
+
+	for _, testData := range testDataList {
+		t.Run(testData.name, func(t *testing.T) {
+			testData.expected.DeviceID = "ABCDEF123456"
+			testData.expected.DeviceType = "Type"
+			status, ok := switchbot.NormalizeStatus(testData.body)
+			assert.True(t, ok)
+			assert.Equal(t, testData.expected, status)
+		})
+	}
+
+	t.Run("NotNormalizer", func(t *testing.T) {
+		_, ok := switchbot.NormalizeStatus(map[string]any{})
+		assert.False(t, ok)
+	})
+
+	t.Run("AllStatusBodies", func(t *testing.T) {
+		normalizerType := reflect.TypeOf((*switchbot.Normalizer)(nil)).Elem()
+		for _, deviceType := range switchbot.DeviceTypes() {
+			method, ok := reflect.TypeOf(switchbot.NewDevice(deviceType, nil)).MethodByName("GetStatus")
+			if !ok {
+				continue
+			}
+			body, _ := method.Type.Out(0).Elem().FieldByName("Body")
+			assert.True(t, body.Type.Implements(normalizerType), "%s does not implement Normalizer", body.Type)
+		}
+	})
+}
@@ -0,0 +1,315 @@
+package switchbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultDailyQuota is the number of requests the SwitchBot API allows for each token per day
+const DefaultDailyQuota = 10000
+
+// RateLimitMode determines what the RateLimiter does when the daily quota is used up
+type RateLimitMode int
+
+const (
+	// RateLimitModeFailFast returns ErrQuotaExceeded without sending the request
+	RateLimitModeFailFast RateLimitMode = iota
+	// RateLimitModeBlock waits until the quota is reset at 00:00 UTC, or until the context is done
+	RateLimitModeBlock
+	// RateLimitModeWarnOnly sends the request anyway and only calls OnThreshold
+	RateLimitModeWarnOnly
+)
+
+// QuotaUsage represents the number of requests sent in the current UTC day
+type QuotaUsage struct {
+	// Date is the UTC day in the "2006-01-02" format
+	Date      string    `json:"date"`
+	Used      int       `json:"used"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	ResetAt   time.Time `json:"resetAt"`
+	// NotifiedThresholds is the list of Thresholds for which OnThreshold has already been called on Date
+	NotifiedThresholds []float64 `json:"notifiedThresholds,omitempty"`
+}
+
+// QuotaStore persists the QuotaUsage so that the counter survives process restarts
+type QuotaStore interface {
+	// Load returns the stored usage. It returns the zero value if nothing is stored yet.
+	Load() (QuotaUsage, error)
+	// Save stores the usage
+	Save(usage QuotaUsage) error
+}
+
+// FileQuotaStore is a QuotaStore that saves the usage as a JSON file
+type FileQuotaStore struct {
+	Path string
+}
+
+// Load reads the usage from the file. A missing file is treated as no usage.
+func (store *FileQuotaStore) Load() (QuotaUsage, error) {
+	usage := QuotaUsage{}
+	data, err := os.ReadFile(store.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return usage, nil
+	}
+	if err != nil {
+		return usage, err
+	}
+	err = json.Unmarshal(data, &usage)
+	return usage, err
+}
+
+// Save writes the usage to the file
+func (store *FileQuotaStore) Save(usage QuotaUsage) error {
+	data, err := json.Marshal(usage)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(store.Path, data)
+}
+
+// writeFileAtomic writes the data to a temporary file in the same directory and renames it to the path,
+// so that a crash never leaves a broken file and concurrent writers do not overwrite each other's temporary file
+func writeFileAtomic(path string, data []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := file.Name()
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+	}
+	return err
+}
+
+// RateLimiter counts the requests sent by the Client per UTC day and limits them to the daily quota.
+// A RateLimiter must not be copied after first use. It can be shared by the Clients that use the same token.
+type RateLimiter struct {
+	// DailyLimit is the number of requests allowed per UTC day. A value of 0 means DefaultDailyQuota.
+	DailyLimit int
+	// Mode determines what happens when the daily quota is used up
+	Mode RateLimitMode
+	// Thresholds is the list of usage ratios (0.0 to 1.0) at which OnThreshold is called, such as 0.8 for 80%
+	Thresholds []float64
+	// OnThreshold is called once per day when the usage reaches each of the Thresholds.
+	// With a Store, the notified thresholds are saved with the usage, so a restart does not call it again.
+	OnThreshold func(usage QuotaUsage, threshold float64)
+	// Store persists the usage. If nil, the usage is kept only in memory.
+	Store QuotaStore
+
+	mu       sync.Mutex
+	loaded   bool
+	date     string
+	used     int
+	notified map[float64]bool
+	// version is incremented on every change of the usage, and savedVersion is the version written to the Store
+	version      int
+	savedVersion int
+	// saving is true while a call writes to the Store, so that only one call writes at a time
+	saving bool
+}
+
+// OptionRateLimiter sets the RateLimiter of the Client
+func OptionRateLimiter(rateLimiter *RateLimiter) func(*Client) {
+	return func(client *Client) {
+		client.rateLimiter = rateLimiter
+	}
+}
+
+// QuotaUsage returns the usage of the daily quota.
+// It returns the zero value if the Client has no RateLimiter.
+func (client *Client) QuotaUsage() QuotaUsage {
+	if client.rateLimiter == nil {
+		return QuotaUsage{}
+	}
+	usage, _ := client.rateLimiter.Usage()
+	return usage
+}
+
+// Usage returns the usage of the daily quota
+func (rateLimiter *RateLimiter) Usage() (QuotaUsage, error) {
+	rateLimiter.mu.Lock()
+	defer rateLimiter.mu.Unlock()
+
+	err := rateLimiter.refresh(time.Now())
+	return rateLimiter.usage(time.Now()), err
+}
+
+// Acquire counts one request against the daily quota.
+// It returns ErrQuotaExceeded, or waits for the reset in RateLimitModeBlock, when the quota is used up.
+func (rateLimiter *RateLimiter) Acquire(ctx context.Context) error {
+	for {
+		wait, usage, reached, err := rateLimiter.tryAcquire(time.Now())
+		if err != nil {
+			return err
+		}
+		if wait == 0 {
+			// MEMO: The usage is saved without holding the lock so that the other requests do not wait for the Store.
+			if err := rateLimiter.save(); err != nil {
+				return fmt.Errorf("failed to save quota usage: %w", err)
+			}
+			// MEMO: OnThreshold is called without holding the lock so that it can call Usage.
+			for _, threshold := range reached {
+				rateLimiter.OnThreshold(usage, threshold)
+			}
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// tryAcquire counts one request and returns the thresholds reached by it.
+// If the quota is used up in RateLimitModeBlock, it returns the time to wait instead.
+func (rateLimiter *RateLimiter) tryAcquire(now time.Time) (time.Duration, QuotaUsage, []float64, error) {
+	rateLimiter.mu.Lock()
+	defer rateLimiter.mu.Unlock()
+
+	if err := rateLimiter.refresh(now); err != nil {
+		return 0, QuotaUsage{}, nil, err
+	}
+
+	if rateLimiter.used >= rateLimiter.limit() {
+		switch rateLimiter.Mode {
+		case RateLimitModeBlock:
+			usage := rateLimiter.usage(now)
+			return usage.ResetAt.Sub(now), usage, nil, nil
+		case RateLimitModeFailFast:
+			return 0, QuotaUsage{}, nil, fmt.Errorf("%w: %d of %d requests used", ErrQuotaExceeded, rateLimiter.used, rateLimiter.limit())
+		}
+	}
+
+	rateLimiter.used++
+	rateLimiter.version++
+	usage := rateLimiter.usage(now)
+	return 0, usage, rateLimiter.reachedThresholds(usage), nil
+}
+
+// save writes the latest usage to the Store. It must be called without holding mu.
+// If another call is already saving, it returns at once, and that call writes the latest usage before it returns.
+func (rateLimiter *RateLimiter) save() error {
+	if rateLimiter.Store == nil {
+		return nil
+	}
+
+	rateLimiter.mu.Lock()
+	defer rateLimiter.mu.Unlock()
+	if rateLimiter.saving {
+		return nil
+	}
+	rateLimiter.saving = true
+	defer func() {
+		rateLimiter.saving = false
+	}()
+
+	for rateLimiter.savedVersion != rateLimiter.version {
+		version := rateLimiter.version
+		usage := rateLimiter.usage(time.Now())
+
+		rateLimiter.mu.Unlock()
+		err := rateLimiter.Store.Save(usage)
+		rateLimiter.mu.Lock()
+		if err != nil {
+			return err
+		}
+		rateLimiter.savedVersion = version
+	}
+	return nil
+}
+
+// refresh loads the stored usage on first use and resets the counter when the UTC day changes
+func (rateLimiter *RateLimiter) refresh(now time.Time) error {
+	today := now.UTC().Format(time.DateOnly)
+
+	if !rateLimiter.loaded {
+		if rateLimiter.Store != nil {
+			stored, err := rateLimiter.Store.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load quota usage: %w", err)
+			}
+			rateLimiter.date = stored.Date
+			rateLimiter.used = stored.Used
+			for _, threshold := range stored.NotifiedThresholds {
+				if rateLimiter.notified == nil {
+					rateLimiter.notified = map[float64]bool{}
+				}
+				rateLimiter.notified[threshold] = true
+			}
+		}
+		rateLimiter.loaded = true
+	}
+
+	if rateLimiter.date != today {
+		rateLimiter.date = today
+		rateLimiter.used = 0
+		rateLimiter.notified = nil
+	}
+	return nil
+}
+
+// reachedThresholds returns the thresholds reached for the first time today and marks them as notified
+func (rateLimiter *RateLimiter) reachedThresholds(usage QuotaUsage) []float64 {
+	if rateLimiter.OnThreshold == nil {
+		return nil
+	}
+	if rateLimiter.notified == nil {
+		rateLimiter.notified = map[float64]bool{}
+	}
+
+	thresholds := append([]float64{}, rateLimiter.Thresholds...)
+	sort.Float64s(thresholds)
+	var reached []float64
+	for _, threshold := range thresholds {
+		if rateLimiter.notified[threshold] || float64(usage.Used) < threshold*float64(usage.Limit) {
+			continue
+		}
+		rateLimiter.notified[threshold] = true
+		reached = append(reached, threshold)
+	}
+	return reached
+}
+
+func (rateLimiter *RateLimiter) limit() int {
+	if rateLimiter.DailyLimit <= 0 {
+		return DefaultDailyQuota
+	}
+	return rateLimiter.DailyLimit
+}
+
+func (rateLimiter *RateLimiter) usage(now time.Time) QuotaUsage {
+	limit := rateLimiter.limit()
+	year, month, day := now.UTC().Date()
+	var notified []float64
+	for threshold := range rateLimiter.notified {
+		notified = append(notified, threshold)
+	}
+	sort.Float64s(notified)
+	return QuotaUsage{
+		Date:               rateLimiter.date,
+		Used:               rateLimiter.used,
+		Limit:              limit,
+		Remaining:          max(limit-rateLimiter.used, 0),
+		ResetAt:            time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC),
+		NotifiedThresholds: notified,
+	}
+}
@@ -0,0 +1,236 @@
+package switchbot_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func newRateLimitTestClient(t *testing.T, rateLimiter *switchbot.RateLimiter) (*switchbot.Client, *helpers.SwitchBotMock, func()) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterScenesMock([]interface{}{})
+	testServer := switchBotMock.NewTestServer()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRateLimiter(rateLimiter))
+	return client, switchBotMock, testServer.Close
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("FailFast", func(t *testing.T) {
+		client, switchBotMock, closeServer := newRateLimitTestClient(t, &switchbot.RateLimiter{DailyLimit: 2})
+		defer closeServer()
+
+		for i := 0; i < 2; i++ {
+			_, err := client.GetScenes()
+			assert.NoError(t, err)
+		}
+		_, err := client.GetScenes()
+		assert.ErrorIs(t, err, switchbot.ErrQuotaExceeded)
+		switchBotMock.AssertCallCount("GET", "/scenes", 2)
+
+		usage := client.QuotaUsage()
+		assert.Equal(t, 2, usage.Used)
+		assert.Equal(t, 2, usage.Limit)
+		assert.Equal(t, 0, usage.Remaining)
+		assert.Equal(t, time.Now().UTC().Format(time.DateOnly), usage.Date)
+		assert.True(t, usage.ResetAt.After(time.Now()))
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		client, switchBotMock, closeServer := newRateLimitTestClient(t, &switchbot.RateLimiter{
+			DailyLimit: 1,
+			Mode:       switchbot.RateLimitModeBlock,
+		})
+		defer closeServer()
+
+		_, err := client.GetScenes()
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = client.GetScenesContext(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		switchBotMock.AssertCallCount("GET", "/scenes", 1)
+	})
+
+	t.Run("WarnOnly", func(t *testing.T) {
+		var reached []float64
+		var usages []switchbot.QuotaUsage
+		client, switchBotMock, closeServer := newRateLimitTestClient(t, &switchbot.RateLimiter{
+			DailyLimit: 4,
+			Mode:       switchbot.RateLimitModeWarnOnly,
+			Thresholds: []float64{1.0, 0.5},
+			OnThreshold: func(usage switchbot.QuotaUsage, threshold float64) {
+				reached = append(reached, threshold)
+				usages = append(usages, usage)
+			},
+		})
+		defer closeServer()
+
+		for i := 0; i < 5; i++ {
+			_, err := client.GetScenes()
+			assert.NoError(t, err)
+		}
+		switchBotMock.AssertCallCount("GET", "/scenes", 5)
+
+		assert.Equal(t, []float64{0.5, 1.0}, reached)
+		assert.Equal(t, 2, usages[0].Used)
+		assert.Equal(t, 4, usages[1].Used)
+		assert.Equal(t, 5, client.QuotaUsage().Used)
+		assert.Equal(t, 0, client.QuotaUsage().Remaining)
+	})
+
+	t.Run("FileQuotaStore", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "quota.json")
+
+		client, _, closeServer := newRateLimitTestClient(t, &switchbot.RateLimiter{Store: &switchbot.FileQuotaStore{Path: path}})
+		defer closeServer()
+		for i := 0; i < 3; i++ {
+			_, err := client.GetScenes()
+			assert.NoError(t, err)
+		}
+
+		restarted, _, closeRestarted := newRateLimitTestClient(t, &switchbot.RateLimiter{Store: &switchbot.FileQuotaStore{Path: path}})
+		defer closeRestarted()
+		assert.Equal(t, 3, restarted.QuotaUsage().Used)
+		assert.Equal(t, switchbot.DefaultDailyQuota-3, restarted.QuotaUsage().Remaining)
+	})
+
+	t.Run("FileQuotaStoreKeepsNotifiedThresholds", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "quota.json")
+		var reached []float64
+		newRateLimiter := func() *switchbot.RateLimiter {
+			return &switchbot.RateLimiter{
+				DailyLimit: 4,
+				Thresholds: []float64{0.5},
+				OnThreshold: func(usage switchbot.QuotaUsage, threshold float64) {
+					reached = append(reached, threshold)
+				},
+				Store: &switchbot.FileQuotaStore{Path: path},
+			}
+		}
+
+		client, _, closeServer := newRateLimitTestClient(t, newRateLimiter())
+		defer closeServer()
+		for i := 0; i < 2; i++ {
+			_, err := client.GetScenes()
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, []float64{0.5}, reached)
+
+		restarted, _, closeRestarted := newRateLimitTestClient(t, newRateLimiter())
+		defer closeRestarted()
+		_, err := restarted.GetScenes()
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{0.5}, reached)
+		assert.Equal(t, 3, restarted.QuotaUsage().Used)
+		assert.Equal(t, []float64{0.5}, restarted.QuotaUsage().NotifiedThresholds)
+	})
+
+	t.Run("FileQuotaStoreResetsOnNewDay", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "quota.json")
+		err := os.WriteFile(path, []byte(`{"date":"2000-01-01","used":9999}`), 0o600)
+		assert.NoError(t, err)
+
+		client, _, closeServer := newRateLimitTestClient(t, &switchbot.RateLimiter{Store: &switchbot.FileQuotaStore{Path: path}})
+		defer closeServer()
+		_, err = client.GetScenes()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.QuotaUsage().Used)
+	})
+
+	t.Run("FileQuotaStoreConcurrentSave", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "quota.json")
+
+		var wg sync.WaitGroup
+		for i := 1; i <= 10; i++ {
+			wg.Add(1)
+			go func(used int) {
+				defer wg.Done()
+				// MEMO: Each writer has its own store, as two processes sharing the file would.
+				store := &switchbot.FileQuotaStore{Path: path}
+				assert.NoError(t, store.Save(switchbot.QuotaUsage{Date: "2025-01-01", Used: used}))
+			}(i)
+		}
+		wg.Wait()
+
+		usage, err := (&switchbot.FileQuotaStore{Path: path}).Load()
+		assert.NoError(t, err)
+		assert.Equal(t, "2025-01-01", usage.Date)
+		entries, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("SlowQuotaStore", func(t *testing.T) {
+		store := &blockingQuotaStore{saving: make(chan struct{}), release: make(chan struct{})}
+		rateLimiter := &switchbot.RateLimiter{Store: store}
+
+		done := make(chan error)
+		go func() {
+			done <- rateLimiter.Acquire(context.Background())
+		}()
+		<-store.saving
+
+		// MEMO: The other requests are counted without waiting for the Save in progress.
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, rateLimiter.Acquire(context.Background()))
+		}
+		usage, err := rateLimiter.Usage()
+		assert.NoError(t, err)
+		assert.Equal(t, 6, usage.Used)
+
+		close(store.release)
+		assert.NoError(t, <-done)
+		assert.Equal(t, 6, store.saved().Used)
+	})
+
+	t.Run("NoRateLimiter", func(t *testing.T) {
+		client := switchbot.NewClient("secret", "token")
+		assert.Equal(t, switchbot.QuotaUsage{}, client.QuotaUsage())
+	})
+}
+
+// blockingQuotaStore is a QuotaStore whose first Save blocks until release is closed
+type blockingQuotaStore struct {
+	saving  chan struct{}
+	release chan struct{}
+
+	mu    sync.Mutex
+	calls int
+	usage switchbot.QuotaUsage
+}
+
+func (store *blockingQuotaStore) Load() (switchbot.QuotaUsage, error) {
+	return switchbot.QuotaUsage{}, nil
+}
+
+func (store *blockingQuotaStore) Save(usage switchbot.QuotaUsage) error {
+	store.mu.Lock()
+	store.calls++
+	first := store.calls == 1
+	store.mu.Unlock()
+	if first {
+		close(store.saving)
+		<-store.release
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.usage = usage
+	return nil
+}
+
+func (store *blockingQuotaStore) saved() switchbot.QuotaUsage {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return store.usage
+}
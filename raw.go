@@ -0,0 +1,115 @@
+package switchbot
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// rawSetter is implemented by the structures that keep the raw JSON they were parsed from
+type rawSetter interface {
+	setRaw(raw json.RawMessage, extra map[string]any)
+}
+
+func (device *CommonDevice) setRaw(raw json.RawMessage, extra map[string]any) {
+	device.Raw = raw
+	device.Extra = extra
+}
+
+func (device *InfraredRemoteDevice) setRaw(raw json.RawMessage, extra map[string]any) {
+	device.Raw = raw
+	device.Extra = extra
+}
+
+func (device *InfraredRemoteOthersDevice) setRaw(raw json.RawMessage, extra map[string]any) {
+	device.Raw = raw
+	device.Extra = extra
+}
+
+// rawGetter is implemented by the structures that keep the raw JSON they were parsed from
+type rawGetter interface {
+	getRaw() json.RawMessage
+}
+
+func (device *CommonDevice) getRaw() json.RawMessage {
+	return device.Raw
+}
+
+func (device *InfraredRemoteDevice) getRaw() json.RawMessage {
+	return device.Raw
+}
+
+func (device *InfraredRemoteOthersDevice) getRaw() json.RawMessage {
+	return device.Raw
+}
+
+// knownFieldsCache caches the JSON field names of each structure type
+var knownFieldsCache sync.Map
+
+// knownFields returns the lower-cased JSON field names of the structure, including the fields of embedded structures
+func knownFields(structType reflect.Type) map[string]bool {
+	if cached, ok := knownFieldsCache.Load(structType); ok {
+		return cached.(map[string]bool)
+	}
+
+	fields := map[string]bool{}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for embedded := range knownFields(fieldType) {
+				fields[embedded] = true
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		// MEMO: encoding/json matches the keys case-insensitively, so the names are compared in lower case.
+		fields[strings.ToLower(name)] = true
+	}
+
+	knownFieldsCache.Store(structType, fields)
+	return fields
+}
+
+// fillRaw sets the raw JSON and the fields unknown to the structure on the target if it keeps them
+func fillRaw(target any, raw json.RawMessage) error {
+	setter, ok := target.(rawSetter)
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+
+	var values map[string]any
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return err
+	}
+
+	known := knownFields(reflect.TypeOf(target).Elem())
+	var extra map[string]any
+	for key, value := range values {
+		if known[strings.ToLower(key)] {
+			continue
+		}
+		if extra == nil {
+			extra = map[string]any{}
+		}
+		extra[key] = value
+	}
+
+	setter.setRaw(raw, extra)
+	return nil
+}
@@ -0,0 +1,98 @@
+package switchbot_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestRawAndExtra(t *testing.T) {
+	t.Run("Devices", func(t *testing.T) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterDevicesMock(
+			[]interface{}{
+				map[string]interface{}{
+					"deviceId":           "ABCDEF123456",
+					"deviceType":         "Curtain",
+					"hubDeviceId":        "123456789",
+					"deviceName":         "CurtainDevice",
+					"enableCloudService": true,
+					"calibrate":          true,
+					"newKey":             "newValue",
+				},
+				map[string]interface{}{
+					"deviceId":    "ABCDEF654321",
+					"deviceType":  "Bot",
+					"hubDeviceId": "123456789",
+					"deviceName":  "BotDevice",
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"deviceId":    "02-202008110034-13",
+					"deviceName":  "TV",
+					"remoteType":  "TV",
+					"hubDeviceId": "FA7310762361",
+					"newRemoteKey": map[string]interface{}{
+						"nested": 1,
+					},
+				},
+			},
+		)
+		testServer := switchBotMock.NewTestServer()
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+		response, err := client.GetDevices()
+		assert.NoError(t, err)
+
+		curtain := response.Body.DeviceList[0].(*switchbot.CurtainDevice)
+		assert.Equal(t, map[string]any{"newKey": "newValue"}, curtain.Extra)
+		rawCurtain := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(curtain.Raw, &rawCurtain))
+		assert.Equal(t, "newValue", rawCurtain["newKey"])
+		assert.Equal(t, true, rawCurtain["calibrate"])
+
+		bot := response.Body.DeviceList[1].(*switchbot.BotDevice)
+		assert.Nil(t, bot.Extra, "Extra is nil when there are no unknown fields")
+		assert.NotEmpty(t, bot.Raw)
+
+		tv := response.Body.InfraredRemoteList[0].(*switchbot.InfraredRemoteTVDevice)
+		assert.Equal(t, map[string]any{"newRemoteKey": map[string]any{"nested": float64(1)}}, tv.Extra)
+		assert.Contains(t, string(tv.Raw), `"newRemoteKey"`)
+
+		// Raw and Extra are not encoded
+		encoded, err := json.Marshal(curtain)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(encoded), "newKey")
+	})
+
+	t.Run("StatusBody", func(t *testing.T) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterStatusMock("ABCDEF123456", map[string]interface{}{
+			"deviceId":     "ABCDEF123456",
+			"deviceType":   "Hub 3",
+			"hubDeviceId":  "ABCDEF123456",
+			"temperature":  25.5,
+			"newAttribute": "value",
+		})
+		testServer := switchBotMock.NewTestServer()
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+		device := &switchbot.Hub3Device{
+			CommonDeviceListItem: switchbot.CommonDeviceListItem{
+				CommonDevice: switchbot.CommonDevice{DeviceID: "ABCDEF123456"},
+				Client:       client,
+			},
+		}
+		status, err := device.GetStatus()
+		assert.NoError(t, err)
+		assert.Equal(t, 25.5, status.Body.Temperature)
+		assert.Equal(t, map[string]any{"newAttribute": "value"}, status.Body.Extra)
+		assert.Contains(t, string(status.Body.Raw), `"newAttribute"`)
+	})
+}
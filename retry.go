@@ -0,0 +1,143 @@
+package switchbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures how the Client retries requests that failed with a transient error.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one. A value of 1 or less disables retries.
+	MaxAttempts int
+	// InitialBackoff is the wait time before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff is the upper limit of the wait time between retries. A value of 0 means no limit.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the wait time grows after each retry. A value less than 1 is treated as 1.
+	Multiplier float64
+	// Jitter is the fraction (0.0 to 1.0) of the wait time that is randomized to spread out retries
+	Jitter float64
+	// RetryableStatusCodes is the list of SwitchBot statusCodes to retry.
+	// HTTP 429 and 5xx responses and network errors are always retried.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent enables retries of requests that must not be sent twice,
+	// such as the "press" command of BotDevice or the "volumeAdd" command of InfraredRemoteTVDevice.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with recommended settings.
+// It retries up to 3 attempts and does not retry non-idempotent requests.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       500 * time.Millisecond,
+		MaxBackoff:           10 * time.Second,
+		Multiplier:           2,
+		Jitter:               0.2,
+		RetryableStatusCodes: []int{StatusCodeHubOffline, StatusCodeDeviceInternalError},
+	}
+}
+
+// OptionRetryPolicy sets the RetryPolicy of the Client
+func OptionRetryPolicy(retryPolicy RetryPolicy) func(*Client) {
+	return func(client *Client) {
+		client.retryPolicy = retryPolicy
+	}
+}
+
+// nonIdempotentCommands is the set of commands that change the state relative to the current state,
+// so sending them twice has a different effect from sending them once.
+var nonIdempotentCommands = map[string]bool{
+	"press":           true,
+	"toggle":          true,
+	"volumeAdd":       true,
+	"volumeSub":       true,
+	"channelAdd":      true,
+	"channelSub":      true,
+	"brightnessUp":    true,
+	"brightnessDown":  true,
+	"setMute":         true,
+	"fastForward":     true,
+	"Rewind":          true,
+	"Next":            true,
+	"Previous":        true,
+	"Pause":           true,
+	"Play":            true,
+	"swing":           true,
+	"timer":           true,
+	"customize":       true,
+	"createKey":       true,
+	"addWaterForHumi": true,
+}
+
+// isIdempotentRequest reports whether the request can be sent more than once without changing the result
+func isIdempotentRequest(method string, path string, requestBody []byte) bool {
+	if method == http.MethodGet {
+		return true
+	}
+
+	if strings.HasPrefix(path, "/scenes/") {
+		return false
+	}
+
+	if strings.HasPrefix(path, "/devices/") && strings.HasSuffix(path, "/commands") {
+		request := ControlRequest{}
+		if err := json.Unmarshal(requestBody, &request); err != nil {
+			return false
+		}
+		return !nonIdempotentCommands[request.Command]
+	}
+
+	return true
+}
+
+// shouldRetry reports whether the request that failed with err on the given attempt should be retried
+func (policy RetryPolicy) shouldRetry(ctx context.Context, err error, attempt int, idempotent bool) bool {
+	if attempt >= policy.MaxAttempts {
+		return false
+	}
+	if !idempotent && !policy.RetryNonIdempotent {
+		return false
+	}
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		// MEMO: Errors other than APIError are network errors, which are worth retrying.
+		return true
+	}
+
+	if errors.Is(apiError, ErrRateLimited) || errors.Is(apiError, ErrServerError) {
+		return true
+	}
+	return slices.Contains(policy.RetryableStatusCodes, apiError.StatusCode)
+}
+
+// backoff returns the wait time before the next attempt
+func (policy RetryPolicy) backoff(err error, attempt int) time.Duration {
+	var apiError *APIError
+	if errors.As(err, &apiError) && apiError.RetryAfter > 0 {
+		return apiError.RetryAfter
+	}
+
+	multiplier := math.Max(policy.Multiplier, 1)
+	wait := float64(policy.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxBackoff > 0 {
+		wait = math.Min(wait, float64(policy.MaxBackoff))
+	}
+
+	jitter := math.Min(math.Max(policy.Jitter, 0), 1)
+	wait -= wait * jitter * rand.Float64()
+
+	return time.Duration(wait)
+}
@@ -0,0 +1,183 @@
+package switchbot_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+)
+
+// sequenceServer returns a test server that responds with the given responses in order, repeating the last one
+func sequenceServer(t *testing.T, responses []func(w http.ResponseWriter)) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var nonces []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		index := len(nonces)
+		nonces = append(nonces, r.Header.Get("nonce"))
+		mu.Unlock()
+
+		if index >= len(responses) {
+			index = len(responses) - 1
+		}
+		responses[index](w)
+	}))
+	return testServer, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, nonces...)
+	}
+}
+
+func statusCodeResponse(statusCode int) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"statusCode":` + strconv.Itoa(statusCode) + `,"body":{},"message":"message"}`))
+	}
+}
+
+func httpStatusResponse(httpStatusCode int, header map[string]string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		for key, value := range header {
+			w.Header().Set(key, value)
+		}
+		w.WriteHeader(httpStatusCode)
+	}
+}
+
+func testRetryPolicy() switchbot.RetryPolicy {
+	policy := switchbot.DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func newRetryTestDevice(client *switchbot.Client) *switchbot.BotDevice {
+	return &switchbot.BotDevice{
+		CommonDeviceListItem: switchbot.CommonDeviceListItem{
+			CommonDevice: switchbot.CommonDevice{
+				DeviceID: "ABCDEF123456",
+			},
+			Client: client,
+		},
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("RetryUntilSuccess", func(t *testing.T) {
+		testServer, nonces := sequenceServer(t, []func(w http.ResponseWriter){
+			statusCodeResponse(171),
+			statusCodeResponse(190),
+			statusCodeResponse(100),
+		})
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(testRetryPolicy()))
+		response, err := newRetryTestDevice(client).TurnOn()
+		assert.NoError(t, err)
+		assert.Equal(t, 100, response.StatusCode)
+
+		assert.Len(t, nonces(), 3)
+		assert.NotEqual(t, nonces()[0], nonces()[1], "each attempt must be signed with a new nonce")
+	})
+
+	t.Run("GiveUpAfterMaxAttempts", func(t *testing.T) {
+		testServer, nonces := sequenceServer(t, []func(w http.ResponseWriter){
+			statusCodeResponse(171),
+		})
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(testRetryPolicy()))
+		_, err := newRetryTestDevice(client).GetStatus()
+		assert.ErrorIs(t, err, switchbot.ErrHubOffline)
+		assert.Len(t, nonces(), 3)
+	})
+
+	t.Run("NotRetryableStatusCode", func(t *testing.T) {
+		testServer, nonces := sequenceServer(t, []func(w http.ResponseWriter){
+			statusCodeResponse(152),
+		})
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(testRetryPolicy()))
+		_, err := newRetryTestDevice(client).TurnOn()
+		assert.ErrorIs(t, err, switchbot.ErrDeviceNotFound)
+		assert.Len(t, nonces(), 1)
+	})
+
+	t.Run("ServerError", func(t *testing.T) {
+		testServer, nonces := sequenceServer(t, []func(w http.ResponseWriter){
+			httpStatusResponse(http.StatusInternalServerError, nil),
+			statusCodeResponse(100),
+		})
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(testRetryPolicy()))
+		_, err := newRetryTestDevice(client).TurnOff()
+		assert.NoError(t, err)
+		assert.Len(t, nonces(), 2)
+	})
+
+	t.Run("NonIdempotentCommandIsNotRetried", func(t *testing.T) {
+		testServer, nonces := sequenceServer(t, []func(w http.ResponseWriter){
+			statusCodeResponse(171),
+			statusCodeResponse(100),
+		})
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(testRetryPolicy()))
+		_, err := newRetryTestDevice(client).Press()
+		assert.ErrorIs(t, err, switchbot.ErrHubOffline)
+		assert.Len(t, nonces(), 1)
+	})
+
+	t.Run("NonIdempotentCommandOptIn", func(t *testing.T) {
+		testServer, nonces := sequenceServer(t, []func(w http.ResponseWriter){
+			statusCodeResponse(171),
+			statusCodeResponse(100),
+		})
+		defer testServer.Close()
+
+		policy := testRetryPolicy()
+		policy.RetryNonIdempotent = true
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(policy))
+		_, err := newRetryTestDevice(client).Press()
+		assert.NoError(t, err)
+		assert.Len(t, nonces(), 2)
+	})
+
+	t.Run("NoRetryByDefault", func(t *testing.T) {
+		testServer, nonces := sequenceServer(t, []func(w http.ResponseWriter){
+			statusCodeResponse(171),
+			statusCodeResponse(100),
+		})
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+		_, err := newRetryTestDevice(client).TurnOn()
+		assert.ErrorIs(t, err, switchbot.ErrHubOffline)
+		assert.Len(t, nonces(), 1)
+	})
+
+	t.Run("RetryAfter", func(t *testing.T) {
+		testServer, nonces := sequenceServer(t, []func(w http.ResponseWriter){
+			httpStatusResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "1"}),
+			statusCodeResponse(100),
+		})
+		defer testServer.Close()
+
+		client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL), switchbot.OptionRetryPolicy(testRetryPolicy()))
+		start := time.Now()
+		_, err := client.GetDevices()
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		assert.Len(t, nonces(), 2)
+	})
+}
@@ -0,0 +1,70 @@
+package switchbot
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Scene represents a manual scene created in the SwitchBot app
+type Scene struct {
+	Client    API    `json:"-"`
+	SceneID   string `json:"sceneId"`
+	SceneName string `json:"sceneName"`
+}
+
+// GetScenesResponse represents the response of `GET /v1.1/scenes`
+type GetScenesResponse struct {
+	CommonResponse
+	Body []*Scene `json:"body"`
+}
+
+// GetScenesResponseParser returns a ResponseParser that parses the scene list and sets the Client for each Scene
+func GetScenesResponseParser(response *GetScenesResponse) ResponseParser {
+	return func(client *Client, bodyBytes []byte) error {
+		err := json.Unmarshal(bodyBytes, response)
+		if err != nil {
+			return err
+		}
+
+		for _, scene := range response.Body {
+			scene.Client = client
+		}
+
+		return nil
+	}
+}
+
+// GetScenes retrieves the list of manual scenes
+func (client *Client) GetScenes() (*GetScenesResponse, error) {
+	return client.GetScenesContext(context.Background())
+}
+
+// GetScenesContext is the same as GetScenes, but uses the given context for the request
+func (client *Client) GetScenesContext(ctx context.Context) (*GetScenesResponse, error) {
+	response := &GetScenesResponse{}
+	err := client.GetRequestContext(ctx, "/scenes", GetScenesResponseParser(response))
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ExecuteScene sends a request to execute the manual scene of the sceneID
+func (client *Client) ExecuteScene(sceneID string) (*CommonResponse, error) {
+	return client.ExecuteSceneContext(context.Background(), sceneID)
+}
+
+// ExecuteSceneContext is the same as ExecuteScene, but uses the given context for the request
+func (client *Client) ExecuteSceneContext(ctx context.Context, sceneID string) (*CommonResponse, error) {
+	return client.PostRequestContext(ctx, "/scenes/"+sceneID+"/execute", struct{}{})
+}
+
+// Execute sends a request to execute the manual scene
+func (scene *Scene) Execute() (*CommonResponse, error) {
+	return scene.ExecuteContext(context.Background())
+}
+
+// ExecuteContext is the same as Execute, but uses the given context for the request
+func (scene *Scene) ExecuteContext(ctx context.Context) (*CommonResponse, error) {
+	return scene.Client.ExecuteSceneContext(ctx, scene.SceneID)
+}
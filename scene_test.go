@@ -0,0 +1,71 @@
+package switchbot_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestGetScenes(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterScenesMock([]interface{}{
+		map[string]interface{}{
+			"sceneId":   "T02-202009221414-48924101",
+			"sceneName": "Good Night",
+		},
+		map[string]interface{}{
+			"sceneId":   "T02-202009221414-48924102",
+			"sceneName": "Good Morning",
+		},
+	})
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+
+	response, err := client.GetScenes()
+	assert.NoError(t, err)
+
+	switchBotMock.AssertCallCount(http.MethodGet, "/scenes", 1)
+
+	assertResponse(t, &response.CommonResponse)
+	assertBody(t, response.Body, []*switchbot.Scene{
+		{
+			Client:    client,
+			SceneID:   "T02-202009221414-48924101",
+			SceneName: "Good Night",
+		},
+		{
+			Client:    client,
+			SceneID:   "T02-202009221414-48924102",
+			SceneName: "Good Morning",
+		},
+	})
+
+	data, err := json.Marshal(response.Body[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"sceneId":"T02-202009221414-48924101","sceneName":"Good Night"}`, string(data))
+}
+
+func TestSceneExecute(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterSceneExecuteMock("T02-202009221414-48924101")
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	scene := &switchbot.Scene{
+		Client:  client,
+		SceneID: "T02-202009221414-48924101",
+	}
+
+	response, err := scene.Execute()
+	assert.NoError(t, err)
+	assertResponse(t, response)
+
+	switchBotMock.AssertCallCount(http.MethodPost, "/scenes/T02-202009221414-48924101/execute", 1)
+}
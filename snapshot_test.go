@@ -0,0 +1,95 @@
+package switchbot_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestSnapshot(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterDevicesMock(
+		[]interface{}{
+			map[string]interface{}{
+				"deviceId":           "BOT000000001",
+				"deviceType":         "Bot",
+				"hubDeviceId":        "HUB000000001",
+				"deviceName":         "Bot",
+				"enableCloudService": true,
+				"newField":           "new value",
+			},
+		},
+		[]interface{}{
+			map[string]interface{}{
+				"deviceId":    "02-202008110034-13",
+				"deviceName":  "Air Conditioner",
+				"remoteType":  "Air Conditioner",
+				"hubDeviceId": "HUB000000001",
+			},
+		},
+	)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	path := filepath.Join(t.TempDir(), "devices.json")
+
+	fresh, err := client.GetDevicesWithSnapshot(path, time.Hour)
+	assert.NoError(t, err)
+	switchBotMock.AssertCallCount(http.MethodGet, "/devices", 1)
+
+	t.Run("RestoreFromFile", func(t *testing.T) {
+		restored, err := client.GetDevicesWithSnapshot(path, time.Hour)
+		assert.NoError(t, err)
+		switchBotMock.AssertCallCount(http.MethodGet, "/devices", 1)
+
+		bot, ok := restored.Body.DeviceList[0].(*switchbot.BotDevice)
+		assert.True(t, ok)
+		assert.Equal(t, "BOT000000001", bot.DeviceID)
+		assert.Equal(t, client, bot.Client)
+		assert.Equal(t, map[string]any{"newField": "new value"}, bot.Extra)
+		assert.JSONEq(t, string(fresh.Body.DeviceList[0].(*switchbot.BotDevice).Raw), string(bot.Raw))
+
+		airConditioner, ok := restored.Body.InfraredRemoteList[0].(*switchbot.InfraredRemoteAirConditionerDevice)
+		assert.True(t, ok)
+		assert.Equal(t, "Air Conditioner", airConditioner.DeviceName)
+		assert.Equal(t, client, airConditioner.Client)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		time.Sleep(time.Millisecond)
+		_, err := client.GetDevicesWithSnapshot(path, time.Nanosecond)
+		assert.NoError(t, err)
+		switchBotMock.AssertCallCount(http.MethodGet, "/devices", 2)
+	})
+
+	t.Run("StaleOnError", func(t *testing.T) {
+		failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer failingServer.Close()
+
+		failingClient := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(failingServer.URL))
+		stale, err := failingClient.GetDevicesWithSnapshot(path, time.Nanosecond)
+		assert.ErrorIs(t, err, switchbot.ErrServerError)
+		assert.Len(t, stale.Body.DeviceList, 1)
+		assert.Len(t, stale.Body.InfraredRemoteList, 1)
+	})
+
+	t.Run("UnsupportedVersion", func(t *testing.T) {
+		versionPath := filepath.Join(t.TempDir(), "devices.json")
+		data, _ := json.Marshal(map[string]any{"version": 999})
+		assert.NoError(t, os.WriteFile(versionPath, data, 0o600))
+
+		_, err := switchbot.LoadSnapshot(versionPath)
+		assert.ErrorIs(t, err, switchbot.ErrUnsupportedSnapshotVersion)
+	})
+}
@@ -2,14 +2,16 @@ package switchbot
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -21,11 +23,14 @@ const (
 )
 
 type Client struct {
-	secret     string
-	token      string
-	httpClient http.Client
-	debug      bool
-	baseApiURL string
+	secret      string
+	token       string
+	httpClient  *http.Client
+	middlewares []Middleware
+	logger      *slog.Logger
+	baseApiURL  string
+	retryPolicy RetryPolicy
+	rateLimiter *RateLimiter
 }
 
 type CommonResponse struct {
@@ -37,7 +42,7 @@ func NewClient(secret string, token string, options ...Option) *Client {
 	client := &Client{
 		secret:     secret,
 		token:      token,
-		httpClient: http.Client{},
+		httpClient: &http.Client{},
 		baseApiURL: DefaultBaseApiURL,
 	}
 
@@ -45,14 +50,25 @@ func NewClient(secret string, token string, options ...Option) *Client {
 		opt(client)
 	}
 
+	if len(client.middlewares) > 0 {
+		client.httpClient = client.wrapTransport()
+	}
+
 	return client
 }
 
 type Option func(*Client)
 
+// OptionDebug logs every request and response to stderr at the debug level.
+//
+// Deprecated: Use OptionLogger, which allows choosing the destination and the level.
 func OptionDebug(debugFlag bool) func(*Client) {
 	return func(client *Client) {
-		client.debug = debugFlag
+		if !debugFlag {
+			client.logger = nil
+			return
+		}
+		client.logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
 	}
 }
 
@@ -62,8 +78,65 @@ func OptionBaseApiURL(baseApiURL string) func(*Client) {
 	}
 }
 
+// OptionHTTPClient sets the http.Client used to send requests.
+// Use it to configure timeouts, proxies or TLS settings. A nil http.Client is ignored and the default one is kept.
+func OptionHTTPClient(httpClient *http.Client) func(*Client) {
+	return func(client *Client) {
+		if httpClient == nil {
+			return
+		}
+		client.httpClient = httpClient
+	}
+}
+
+// Middleware wraps an http.RoundTripper to add behavior such as logging or header injection
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc is an adapter to use an ordinary function as an http.RoundTripper
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req)
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// OptionMiddleware adds middlewares to the transport of the http.Client.
+// The first middleware is the outermost one, and each middleware sees every attempt of a retried request with its signed headers.
+func OptionMiddleware(middlewares ...Middleware) func(*Client) {
+	return func(client *Client) {
+		client.middlewares = append(client.middlewares, middlewares...)
+	}
+}
+
+// wrapTransport returns a copy of the http.Client whose transport is wrapped with the middlewares,
+// so that the http.Client passed to OptionHTTPClient is not modified
+func (client *Client) wrapTransport() *http.Client {
+	httpClient := *client.httpClient
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		transport = client.middlewares[i](transport)
+	}
+	httpClient.Transport = transport
+	return &httpClient
+}
+
 type ResponseParser func(client *Client, bodyBytes []byte) error
 
+// requestPathKey is the context key of the path passed to the request methods of the Client
+type requestPathKey struct{}
+
+// RequestPath returns the path of a request sent by the Client relative to the base API URL, such as "/devices".
+// It is intended for middlewares that should not depend on OptionBaseApiURL. For other requests, it returns the path of the URL.
+func RequestPath(req *http.Request) string {
+	if path, ok := req.Context().Value(requestPathKey{}).(string); ok {
+		return path
+	}
+	return req.URL.Path
+}
+
 func (client *Client) setHeader(req *http.Request) error {
 	nonce := uuid.NewString()
 	timestamp := time.Now().UnixMilli()
@@ -88,76 +161,131 @@ func (client *Client) setHeader(req *http.Request) error {
 }
 
 func (client *Client) GetRequest(path string, parser ResponseParser) error {
-	url := fmt.Sprintf("%s%s", client.baseApiURL, path)
-	req, err := http.NewRequest("GET", url, nil)
+	return client.GetRequestContext(context.Background(), path, parser)
+}
+
+// GetRequestContext is the same as GetRequest, but uses the given context for the request
+func (client *Client) GetRequestContext(ctx context.Context, path string, parser ResponseParser) error {
+	return client.doRequest(ctx, http.MethodGet, path, nil, parser)
+}
+
+func (client *Client) PostRequest(path string, request interface{}) (*CommonResponse, error) {
+	return client.PostRequestContext(context.Background(), path, request)
+}
+
+// PostRequestContext is the same as PostRequest, but uses the given context for the request
+func (client *Client) PostRequestContext(ctx context.Context, path string, request interface{}) (*CommonResponse, error) {
+	response := &CommonResponse{}
+	err := client.PostRequestWithParserContext(ctx, path, request, func(client *Client, bodyBytes []byte) error {
+		return json.Unmarshal(bodyBytes, response)
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = client.setHeader(req)
+	return response, nil
+}
+
+// PostRequestWithParser sends a POST request and parses the response body with the given parser
+func (client *Client) PostRequestWithParser(path string, request interface{}, parser ResponseParser) error {
+	return client.PostRequestWithParserContext(context.Background(), path, request, parser)
+}
+
+// PostRequestWithParserContext is the same as PostRequestWithParser, but uses the given context for the request
+func (client *Client) PostRequestWithParserContext(ctx context.Context, path string, request interface{}, parser ResponseParser) error {
+	requestBodyJson, err := json.Marshal(request)
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.httpClient.Do(req)
-	if err != nil {
-		return err
+	return client.doRequest(ctx, http.MethodPost, path, requestBodyJson, parser)
+}
+
+// doRequest sends the request, retrying it according to the RetryPolicy, then passes the response body to the parser
+func (client *Client) doRequest(ctx context.Context, method string, path string, requestBody []byte, parser ResponseParser) error {
+	idempotent := isIdempotentRequest(method, path, requestBody)
+
+	for attempt := 1; ; attempt++ {
+		// MEMO: Every attempt, including retries, counts against the daily quota.
+		if client.rateLimiter != nil {
+			if err := client.rateLimiter.Acquire(ctx); err != nil {
+				return err
+			}
+		}
+
+		responseBodyBytes, err := client.send(ctx, method, path, requestBody, attempt)
+		if err == nil {
+			return parser(client, responseBodyBytes)
+		}
+
+		if !client.retryPolicy.shouldRetry(ctx, err, attempt, idempotent) {
+			return err
+		}
+
+		timer := time.NewTimer(client.retryPolicy.backoff(err, attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
 	}
-	defer resp.Body.Close()
+}
 
-	responseBodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return err
+// send signs and sends a single request, then checks the response for errors
+func (client *Client) send(ctx context.Context, method string, path string, requestBody []byte, attempt int) ([]byte, error) {
+	start := time.Now()
+	req, resp, responseBodyBytes, err := client.roundTrip(ctx, method, path, requestBody)
+	if err == nil {
+		err = checkResponse(resp, path, responseBodyBytes)
 	}
 
-	if client.debug {
-		log.Printf("Response: %s", string(responseBodyBytes))
+	if client.logger != nil {
+		client.logRequest(ctx, requestLog{
+			request:      req,
+			response:     resp,
+			requestBody:  requestBody,
+			responseBody: responseBodyBytes,
+			path:         path,
+			attempt:      attempt,
+			duration:     time.Since(start),
+			err:          err,
+		})
 	}
 
-	err = parser(client, responseBodyBytes)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	return nil
+	return responseBodyBytes, nil
 }
 
-func (client *Client) PostRequest(path string, request ControlRequest) (*CommonResponse, error) {
+// roundTrip builds and signs the request, sends it and reads the response body
+func (client *Client) roundTrip(ctx context.Context, method string, path string, requestBody []byte) (*http.Request, *http.Response, []byte, error) {
 	url := fmt.Sprintf("%s%s", client.baseApiURL, path)
-	requestBodyJson, err := json.Marshal(request)
-	if err != nil {
-		return nil, err
+	var body io.Reader
+	if requestBody != nil {
+		body = bytes.NewReader(requestBody)
 	}
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBodyJson))
+	req, err := http.NewRequestWithContext(context.WithValue(ctx, requestPathKey{}, path), method, url, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	err = client.setHeader(req)
 	if err != nil {
-		return nil, err
+		return req, nil, nil, err
 	}
 
 	resp, err := client.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return req, nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	responseBodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return req, resp, nil, err
 	}
 
-	if client.debug {
-		log.Printf("Response: %s", string(responseBodyBytes))
-	}
-
-	response := &CommonResponse{}
-	err = json.Unmarshal(responseBodyBytes, response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return req, resp, responseBodyBytes, nil
 }
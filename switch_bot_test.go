@@ -0,0 +1,119 @@
+package switchbot_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func headerMiddleware(key string, value string, calls *[]string) switchbot.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return switchbot.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			*calls = append(*calls, value)
+			req = req.Clone(req.Context())
+			req.Header.Add(key, value)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func TestOptionMiddleware(t *testing.T) {
+	var headers []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Values("X-Test")
+		assert.NotEmpty(t, r.Header.Get("sign"), "middlewares must see the signed request")
+		_, _ = w.Write([]byte(`{"statusCode":100,"body":{},"message":"success"}`))
+	}))
+	defer testServer.Close()
+
+	var calls []string
+	client := switchbot.NewClient(
+		"secret",
+		"token",
+		switchbot.OptionBaseApiURL(testServer.URL),
+		switchbot.OptionMiddleware(headerMiddleware("X-Test", "outer", &calls)),
+		switchbot.OptionMiddleware(headerMiddleware("X-Test", "inner", &calls)),
+	)
+
+	_, err := client.SendCommand("ABCDEF123456", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+	assert.Equal(t, []string{"outer", "inner"}, headers)
+}
+
+func TestOptionHTTPClient(t *testing.T) {
+	t.Run("Timeout", func(t *testing.T) {
+		blocked := make(chan struct{})
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-blocked:
+			}
+		}))
+		defer testServer.Close()
+		defer close(blocked)
+
+		client := switchbot.NewClient(
+			"secret",
+			"token",
+			switchbot.OptionBaseApiURL(testServer.URL),
+			switchbot.OptionHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}),
+		)
+		_, err := client.GetDevices()
+		assert.ErrorContains(t, err, "Client.Timeout exceeded")
+	})
+
+	t.Run("WithMiddleware", func(t *testing.T) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterScenesMock([]interface{}{})
+		testServer := switchBotMock.NewTestServer()
+		defer testServer.Close()
+
+		var transportCalls int
+		httpClient := &http.Client{
+			Transport: switchbot.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				transportCalls++
+				return http.DefaultTransport.RoundTrip(req)
+			}),
+		}
+
+		var calls []string
+		client := switchbot.NewClient(
+			"secret",
+			"token",
+			switchbot.OptionBaseApiURL(testServer.URL),
+			switchbot.OptionHTTPClient(httpClient),
+			switchbot.OptionMiddleware(headerMiddleware("X-Test", "middleware", &calls)),
+		)
+		_, err := client.GetScenes()
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, transportCalls)
+		assert.Equal(t, []string{"middleware"}, calls)
+		_, isFunc := httpClient.Transport.(switchbot.RoundTripperFunc)
+		assert.True(t, isFunc, "the given http.Client must not be modified")
+		switchBotMock.AssertCallCount("GET", "/scenes", 1)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		switchBotMock := helpers.NewSwitchBotMock(t)
+		switchBotMock.RegisterScenesMock([]interface{}{})
+		testServer := switchBotMock.NewTestServer()
+		defer testServer.Close()
+
+		client := switchbot.NewClient(
+			"secret",
+			"token",
+			switchbot.OptionBaseApiURL(testServer.URL),
+			switchbot.OptionHTTPClient(nil),
+		)
+		_, err := client.GetScenes()
+		assert.NoError(t, err)
+		switchBotMock.AssertCallCount("GET", "/scenes", 1)
+	})
+}
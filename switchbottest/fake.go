@@ -0,0 +1,263 @@
+package switchbottest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/yasu89/switch-bot-api-go"
+)
+
+// Fake is an in-memory implementation of switchbot.API for unit tests.
+// It serves canned device lists, statuses and scenes and records the commands and scene executions, without running an HTTP server.
+// A device or scene whose Client is set to the Fake, including the ones it returns, sends its requests to the Fake.
+type Fake struct {
+	// OnCommand is called for each command after it is recorded. If it returns an error, the command fails with it.
+	// It can be used to update the status with SetStatus.
+	OnCommand func(deviceID string, request switchbot.ControlRequest) error
+
+	mu              sync.Mutex
+	devices         []any
+	infraredRemotes []any
+	statuses        map[string]any
+	errors          map[string]error
+	commands        map[string][]switchbot.ControlRequest
+	scenes          []*switchbot.Scene
+	sceneExecutions map[string]int
+}
+
+var _ switchbot.API = (*Fake)(nil)
+
+// New creates an empty Fake
+func New() *Fake {
+	return &Fake{
+		statuses:        map[string]any{},
+		errors:          map[string]error{},
+		commands:        map[string][]switchbot.ControlRequest{},
+		sceneExecutions: map[string]int{},
+	}
+}
+
+// AddDevice adds a physical device, such as *switchbot.BotDevice, with its status body, such as *switchbot.BotDeviceStatusBody.
+// The status can also be a map. A nil status means that the device has no status.
+func (fake *Fake) AddDevice(device switchbot.DeviceListItem, status any) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.devices = append(fake.devices, device)
+	if status != nil {
+		fake.statuses[device.GetDeviceID()] = status
+	}
+}
+
+// AddInfraredRemote adds an infrared remote device, such as *switchbot.InfraredRemoteTVDevice
+func (fake *Fake) AddInfraredRemote(device switchbot.DeviceListItem) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.infraredRemotes = append(fake.infraredRemotes, device)
+}
+
+// SetStatus replaces the status body of the device
+func (fake *Fake) SetStatus(deviceID string, status any) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.statuses[deviceID] = status
+}
+
+// SetError makes the status requests and commands of the device fail with the error. A nil error clears it.
+func (fake *Fake) SetError(deviceID string, err error) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if err == nil {
+		delete(fake.errors, deviceID)
+		return
+	}
+	fake.errors[deviceID] = err
+}
+
+// Commands returns the commands the device has received, in order
+func (fake *Fake) Commands(deviceID string) []switchbot.ControlRequest {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return append([]switchbot.ControlRequest{}, fake.commands[deviceID]...)
+}
+
+// AddScene adds a manual scene
+func (fake *Fake) AddScene(sceneID string, sceneName string) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.scenes = append(fake.scenes, &switchbot.Scene{SceneID: sceneID, SceneName: sceneName})
+}
+
+// SceneExecutions returns the number of times the scene has been executed
+func (fake *Fake) SceneExecutions(sceneID string) int {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return fake.sceneExecutions[sceneID]
+}
+
+// GetDevices returns copies of the devices added to the fake. Their Client is the fake.
+func (fake *Fake) GetDevices() (*switchbot.GetDevicesResponse, error) {
+	return fake.GetDevicesContext(context.Background())
+}
+
+// GetDevicesContext is the same as GetDevices, but uses the given context
+func (fake *Fake) GetDevicesContext(ctx context.Context) (*switchbot.GetDevicesResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fake.mu.Lock()
+	snapshot, err := switchbot.NewSnapshot(&switchbot.GetDevicesResponse{Body: switchbot.GetDevicesResponseBody{
+		DeviceList:         fake.devices,
+		InfraredRemoteList: fake.infraredRemotes,
+	}})
+	fake.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy the fake devices: %w", err)
+	}
+
+	// MEMO: The devices are restored from a snapshot so that the caller gets new structures, as with the real API,
+	// and the devices added to the fake are not modified.
+	return snapshot.Restore(fake)
+}
+
+// GetStatus fills the response with the status of the device
+func (fake *Fake) GetStatus(deviceID string, response any) error {
+	return fake.GetStatusContext(context.Background(), deviceID, response)
+}
+
+// GetStatusContext is the same as GetStatus, but uses the given context
+func (fake *Fake) GetStatusContext(ctx context.Context, deviceID string, response any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	fake.mu.Lock()
+	err := fake.deviceError(deviceID)
+	status, ok := fake.statuses[deviceID]
+	fake.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return notFound(deviceID)
+	}
+	return parseSuccess(status, switchbot.GetDeviceStatusResponseParser(response))
+}
+
+// SendCommand records the command
+func (fake *Fake) SendCommand(deviceID string, request switchbot.ControlRequest) (*switchbot.CommonResponse, error) {
+	return fake.SendCommandContext(context.Background(), deviceID, request)
+}
+
+// SendCommandContext is the same as SendCommand, but uses the given context
+func (fake *Fake) SendCommandContext(ctx context.Context, deviceID string, request switchbot.ControlRequest) (*switchbot.CommonResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fake.mu.Lock()
+	err := fake.deviceError(deviceID)
+	if err == nil && !fake.exists(deviceID) {
+		err = notFound(deviceID)
+	}
+	if err == nil {
+		fake.commands[deviceID] = append(fake.commands[deviceID], request)
+	}
+	onCommand := fake.OnCommand
+	fake.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if onCommand != nil {
+		if err := onCommand(deviceID, request); err != nil {
+			return nil, err
+		}
+	}
+	return &switchbot.CommonResponse{StatusCode: switchbot.StatusCodeSuccess, Message: "success"}, nil
+}
+
+// GetScenes returns copies of the scenes added to the fake. Their Client is the fake.
+func (fake *Fake) GetScenes() (*switchbot.GetScenesResponse, error) {
+	return fake.GetScenesContext(context.Background())
+}
+
+// GetScenesContext is the same as GetScenes, but uses the given context
+func (fake *Fake) GetScenesContext(ctx context.Context) (*switchbot.GetScenesResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	response := &switchbot.GetScenesResponse{
+		CommonResponse: switchbot.CommonResponse{StatusCode: switchbot.StatusCodeSuccess, Message: "success"},
+		Body:           []*switchbot.Scene{},
+	}
+	for _, scene := range fake.scenes {
+		response.Body = append(response.Body, &switchbot.Scene{Client: fake, SceneID: scene.SceneID, SceneName: scene.SceneName})
+	}
+	return response, nil
+}
+
+// ExecuteScene records the execution of the scene
+func (fake *Fake) ExecuteScene(sceneID string) (*switchbot.CommonResponse, error) {
+	return fake.ExecuteSceneContext(context.Background(), sceneID)
+}
+
+// ExecuteSceneContext is the same as ExecuteScene, but uses the given context
+func (fake *Fake) ExecuteSceneContext(ctx context.Context, sceneID string) (*switchbot.CommonResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	for _, scene := range fake.scenes {
+		if scene.SceneID == sceneID {
+			fake.sceneExecutions[sceneID]++
+			return &switchbot.CommonResponse{StatusCode: switchbot.StatusCodeSuccess, Message: "success"}, nil
+		}
+	}
+	return nil, &switchbot.APIError{StatusCode: switchbot.StatusCodeDeviceNotFound, Message: "scene not found"}
+}
+
+// deviceError returns the error set with SetError. It must be called with mu held.
+func (fake *Fake) deviceError(deviceID string) error {
+	return fake.errors[deviceID]
+}
+
+// exists reports whether the device was added to the fake. It must be called with mu held.
+func (fake *Fake) exists(deviceID string) bool {
+	for _, devices := range [][]any{fake.devices, fake.infraredRemotes} {
+		for _, device := range devices {
+			if device.(switchbot.DeviceListItem).GetDeviceID() == deviceID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func notFound(deviceID string) error {
+	return &switchbot.APIError{
+		StatusCode: switchbot.StatusCodeDeviceNotFound,
+		Message:    "device not found",
+		DeviceID:   deviceID,
+	}
+}
+
+// parseSuccess encodes the body as a success response and parses it with the parser of the switchbot package
+func parseSuccess(body any, parser switchbot.ResponseParser) error {
+	bodyBytes, err := json.Marshal(map[string]any{
+		"statusCode": switchbot.StatusCodeSuccess,
+		"message":    "success",
+		"body":       body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode the fake response: %w", err)
+	}
+	return parser(nil, bodyBytes)
+}
@@ -0,0 +1,152 @@
+package switchbottest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/switchbottest"
+)
+
+func newFake() *switchbottest.Fake {
+	fake := switchbottest.New()
+	fake.AddDevice(
+		&switchbot.BotDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{
+			CommonDevice: switchbot.CommonDevice{DeviceID: "BOT000000001", DeviceType: "Bot", HubDeviceId: "HUB000000001"},
+			DeviceName:   "Bot",
+		}},
+		&switchbot.BotDeviceStatusBody{Power: "on", Battery: 90},
+	)
+	fake.AddDevice(
+		&switchbot.HubDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{
+			CommonDevice: switchbot.CommonDevice{DeviceID: "HUB000000001", DeviceType: "Hub Mini", HubDeviceId: "000000000000"},
+			DeviceName:   "Hub Mini",
+		}},
+		nil,
+	)
+	fake.AddInfraredRemote(&switchbot.InfraredRemoteTVDevice{InfraredRemoteDevice: switchbot.InfraredRemoteDevice{
+		DeviceID: "02-202008110034-13", DeviceName: "TV", RemoteType: "TV", HubDeviceId: "HUB000000001",
+	}})
+	fake.AddScene("T02-202009221414-48924101", "Good Night")
+	return fake
+}
+
+func TestFake(t *testing.T) {
+	t.Run("DevicesUseTheFake", func(t *testing.T) {
+		fake := newFake()
+		fake.OnCommand = func(deviceID string, request switchbot.ControlRequest) error {
+			if request.Command == "turnOff" {
+				fake.SetStatus(deviceID, &switchbot.BotDeviceStatusBody{Power: "off", Battery: 90})
+			}
+			return nil
+		}
+
+		response, err := fake.GetDevices()
+		assert.NoError(t, err)
+		bot, err := switchbot.FindOf[*switchbot.BotDevice](response.Collection(), "BOT000000001")
+		assert.NoError(t, err)
+
+		_, err = bot.TurnOff()
+		assert.NoError(t, err)
+		assert.Equal(t, []switchbot.ControlRequest{{CommandType: "command", Command: "turnOff", Parameter: "default"}}, fake.Commands("BOT000000001"))
+
+		status, err := bot.GetStatus()
+		assert.NoError(t, err)
+		assert.Equal(t, "off", status.Body.Power)
+
+		tv, err := switchbot.FindOf[*switchbot.InfraredRemoteTVDevice](response.Collection(), "02-202008110034-13")
+		assert.NoError(t, err)
+		_, err = tv.TurnOn()
+		assert.NoError(t, err)
+		assert.Len(t, fake.Commands("02-202008110034-13"), 1)
+	})
+
+	t.Run("AsAPI", func(t *testing.T) {
+		var api switchbot.API = newFake()
+
+		response, err := api.GetDevices()
+		assert.NoError(t, err)
+		assert.Len(t, response.Body.DeviceList, 2)
+		assert.Len(t, response.Body.InfraredRemoteList, 1)
+		_, ok := response.Body.DeviceList[1].(*switchbot.HubDevice)
+		assert.True(t, ok)
+
+		status := &switchbot.BotDeviceStatusResponse{}
+		assert.NoError(t, api.GetStatus("BOT000000001", status))
+		assert.Equal(t, 90, status.Body.Battery)
+
+		assert.ErrorIs(t, api.GetStatus("HUB000000001", &switchbot.BotDeviceStatusResponse{}), switchbot.ErrDeviceNotFound)
+		_, err = api.SendCommand("UNKNOWN00001", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+		assert.ErrorIs(t, err, switchbot.ErrDeviceNotFound)
+	})
+
+	t.Run("ReturnsCopies", func(t *testing.T) {
+		added := &switchbot.BotDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{
+			CommonDevice: switchbot.CommonDevice{DeviceID: "BOT000000001", DeviceType: "Bot", HubDeviceId: "HUB000000001"},
+			DeviceName:   "Bot",
+		}}
+		fake := switchbottest.New()
+		fake.AddDevice(added, &switchbot.BotDeviceStatusBody{Power: "on"})
+
+		response, err := fake.GetDevices()
+		assert.NoError(t, err)
+		assert.Equal(t, switchbot.StatusCodeSuccess, response.StatusCode)
+		bot, err := switchbot.FindOf[*switchbot.BotDevice](response.Collection(), "BOT000000001")
+		assert.NoError(t, err)
+		assert.NotSame(t, added, bot)
+		assert.Same(t, fake, bot.Client)
+		assert.Equal(t, "Bot", bot.DeviceName)
+		assert.Nil(t, added.Client)
+	})
+
+	t.Run("HandBuiltDevice", func(t *testing.T) {
+		fake := newFake()
+		bot := &switchbot.BotDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{
+			CommonDevice: switchbot.CommonDevice{DeviceID: "BOT000000001", DeviceType: "Bot"},
+			Client:       fake,
+		}}
+
+		_, err := bot.Press()
+		assert.NoError(t, err)
+		assert.Len(t, fake.Commands("BOT000000001"), 1)
+		status, err := bot.GetStatus()
+		assert.NoError(t, err)
+		assert.Equal(t, "on", status.Body.Power)
+	})
+
+	t.Run("Scenes", func(t *testing.T) {
+		fake := newFake()
+		response, err := fake.GetScenes()
+		assert.NoError(t, err)
+		assert.Len(t, response.Body, 1)
+		assert.Equal(t, "Good Night", response.Body[0].SceneName)
+
+		_, err = response.Body[0].Execute()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fake.SceneExecutions("T02-202009221414-48924101"))
+
+		_, err = fake.ExecuteScene("UNKNOWN")
+		assert.ErrorIs(t, err, switchbot.ErrDeviceNotFound)
+	})
+
+	t.Run("SetError", func(t *testing.T) {
+		fake := newFake()
+		fake.SetError("BOT000000001", &switchbot.APIError{StatusCode: switchbot.StatusCodeHubOffline})
+
+		_, err := fake.SendCommand("BOT000000001", switchbot.ControlRequest{CommandType: "command", Command: "press", Parameter: "default"})
+		assert.ErrorIs(t, err, switchbot.ErrHubOffline)
+		assert.Empty(t, fake.Commands("BOT000000001"))
+
+		fake.SetError("BOT000000001", nil)
+		_, err = fake.SendCommand("BOT000000001", switchbot.ControlRequest{CommandType: "command", Command: "press", Parameter: "default"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("CanceledContext", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := newFake().GetDevicesContext(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,175 @@
+package switchbot
+
+import (
+	"context"
+	"errors"
+)
+
+// noHubDeviceID is the hubDeviceId reported by devices that connect to the cloud directly
+const noHubDeviceID = "000000000000"
+
+// HubNode represents a hub and the devices that connect to the cloud through it
+type HubNode struct {
+	Hub DeviceListItem
+	// Devices is the list of physical devices connected to the hub via BLE
+	Devices []DeviceListItem
+	// InfraredRemotes is the list of infrared remote devices learned by the hub
+	InfraredRemotes []DeviceListItem
+}
+
+// Dependents returns the physical devices and infrared remote devices of the hub
+func (node *HubNode) Dependents() []DeviceListItem {
+	dependents := append([]DeviceListItem{}, node.Devices...)
+	return append(dependents, node.InfraredRemotes...)
+}
+
+// Topology represents how the devices are connected to the hubs
+type Topology struct {
+	// Hubs is the list of hubs, and of other devices that are referenced as a hub by any device
+	Hubs []*HubNode
+	// Standalone is the list of devices that are not hubs and connect to the cloud directly, such as Wi-Fi devices
+	Standalone []DeviceListItem
+	// Orphaned is the list of devices whose hub is not in the device list
+	Orphaned []DeviceListItem
+	// CloudServiceDisabled is the list of physical devices whose EnableCloudService is false
+	CloudServiceDisabled []DeviceListItem
+
+	hubsByID map[string]*HubNode
+	parentOf map[string]string
+}
+
+// GetTopology retrieves the device list and builds the Topology from it
+func (client *Client) GetTopology() (*Topology, error) {
+	return client.GetTopologyContext(context.Background())
+}
+
+// GetTopologyContext is the same as GetTopology, but uses the given context for the request
+func (client *Client) GetTopologyContext(ctx context.Context) (*Topology, error) {
+	response, err := client.GetDevicesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewTopology(response.Collection()), nil
+}
+
+// NewTopology builds the Topology from the devices in the collection
+func NewTopology(collection *DeviceCollection) *Topology {
+	topology := &Topology{
+		hubsByID: map[string]*HubNode{},
+		parentOf: map[string]string{},
+	}
+
+	devicesByID := map[string]DeviceListItem{}
+	referencedAsHub := map[string]bool{}
+	for _, item := range collection.items {
+		devicesByID[item.GetDeviceID()] = item
+		if hubID := parentHubID(item); hubID != "" {
+			referencedAsHub[hubID] = true
+		}
+	}
+
+	for _, item := range collection.items {
+		if isHub(item) || referencedAsHub[item.GetDeviceID()] {
+			node := &HubNode{Hub: item}
+			topology.Hubs = append(topology.Hubs, node)
+			topology.hubsByID[item.GetDeviceID()] = node
+		}
+	}
+
+	for _, item := range collection.items {
+		if cloud, ok := item.(cloudServiceReporter); ok && !cloud.cloudServiceEnabled() {
+			topology.CloudServiceDisabled = append(topology.CloudServiceDisabled, item)
+		}
+
+		hubID := parentHubID(item)
+		if hubID == "" {
+			if _, isNode := topology.hubsByID[item.GetDeviceID()]; !isNode {
+				topology.Standalone = append(topology.Standalone, item)
+			}
+			continue
+		}
+
+		node, ok := topology.hubsByID[hubID]
+		if !ok {
+			topology.Orphaned = append(topology.Orphaned, item)
+			continue
+		}
+		topology.parentOf[item.GetDeviceID()] = hubID
+		if _, isInfrared := item.(infraredRemote); isInfrared {
+			node.InfraredRemotes = append(node.InfraredRemotes, item)
+		} else {
+			node.Devices = append(node.Devices, item)
+		}
+	}
+
+	return topology
+}
+
+// Hub returns the HubNode of the hub with the given ID
+func (topology *Topology) Hub(hubDeviceID string) (*HubNode, bool) {
+	node, ok := topology.hubsByID[hubDeviceID]
+	return node, ok
+}
+
+// HubOf returns the HubNode of the hub the device with the given ID connects through
+func (topology *Topology) HubOf(deviceID string) (*HubNode, bool) {
+	hubID, ok := topology.parentOf[deviceID]
+	if !ok {
+		return nil, false
+	}
+	return topology.Hub(hubID)
+}
+
+// Unreachable returns the devices that cannot be reached because of the given error.
+// If the error matches ErrHubOffline, it returns the hub and all of its dependents. Otherwise it returns nil.
+func (topology *Topology) Unreachable(err error) []DeviceListItem {
+	var apiError *APIError
+	if !errors.As(err, &apiError) || !errors.Is(apiError, ErrHubOffline) || apiError.DeviceID == "" {
+		return nil
+	}
+
+	node, ok := topology.Hub(apiError.DeviceID)
+	if !ok {
+		node, ok = topology.HubOf(apiError.DeviceID)
+	}
+	if !ok {
+		return nil
+	}
+	return append([]DeviceListItem{node.Hub}, node.Dependents()...)
+}
+
+// cloudServiceReporter is implemented by the physical devices through the embedded CommonDeviceListItem
+type cloudServiceReporter interface {
+	cloudServiceEnabled() bool
+}
+
+func (device *CommonDeviceListItem) cloudServiceEnabled() bool {
+	return device.EnableCloudService
+}
+
+// infraredRemote is implemented by the infrared remote devices
+type infraredRemote interface {
+	isInfraredRemote()
+}
+
+func (device *InfraredRemoteDevice) isInfraredRemote() {}
+
+func (device *InfraredRemoteOthersDevice) isInfraredRemote() {}
+
+// isHub reports whether the device is a hub model
+func isHub(item DeviceListItem) bool {
+	switch item.(type) {
+	case *HubDevice, *Hub2Device, *Hub3Device:
+		return true
+	}
+	return false
+}
+
+// parentHubID returns the ID of the hub the device connects through, or an empty string if it connects directly
+func parentHubID(item DeviceListItem) string {
+	hubID := item.GetHubDeviceID()
+	if hubID == "" || hubID == noHubDeviceID || hubID == item.GetDeviceID() {
+		return ""
+	}
+	return hubID
+}
@@ -0,0 +1,85 @@
+package switchbot_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func deviceIDs(devices []switchbot.DeviceListItem) []string {
+	var ids []string
+	for _, device := range devices {
+		ids = append(ids, device.GetDeviceID())
+	}
+	return ids
+}
+
+func TestGetTopology(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterDevicesMock(
+		[]interface{}{
+			map[string]interface{}{"deviceId": "HUB000000001", "deviceType": "Hub 2", "hubDeviceId": "000000000000", "deviceName": "Hub 2", "enableCloudService": true},
+			map[string]interface{}{"deviceId": "HUB000000002", "deviceType": "Hub Mini", "hubDeviceId": "HUB000000002", "deviceName": "Hub Mini", "enableCloudService": true},
+			map[string]interface{}{"deviceId": "BOT000000001", "deviceType": "Bot", "hubDeviceId": "HUB000000001", "deviceName": "Bot", "enableCloudService": true},
+			map[string]interface{}{"deviceId": "METER0000001", "deviceType": "Meter", "hubDeviceId": "HUB000000001", "deviceName": "Meter", "enableCloudService": false},
+			map[string]interface{}{"deviceId": "PLUG00000001", "deviceType": "Plug Mini (JP)", "hubDeviceId": "", "deviceName": "Plug", "enableCloudService": true},
+			map[string]interface{}{"deviceId": "LOCK00000001", "deviceType": "Smart Lock", "hubDeviceId": "HUB999999999", "deviceName": "Lock", "enableCloudService": true},
+		},
+		[]interface{}{
+			map[string]interface{}{"deviceId": "02-202008110034-13", "deviceName": "TV", "remoteType": "TV", "hubDeviceId": "HUB000000002"},
+			map[string]interface{}{"deviceId": "02-202008110034-14", "deviceName": "Light", "remoteType": "Light", "hubDeviceId": "HUB000000001"},
+		},
+	)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+	topology, err := client.GetTopology()
+	assert.NoError(t, err)
+
+	assert.Len(t, topology.Hubs, 2)
+	hub2, ok := topology.Hub("HUB000000001")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"BOT000000001", "METER0000001"}, deviceIDs(hub2.Devices))
+	assert.Equal(t, []string{"02-202008110034-14"}, deviceIDs(hub2.InfraredRemotes))
+
+	hubMini, ok := topology.Hub("HUB000000002")
+	assert.True(t, ok)
+	assert.Empty(t, hubMini.Devices)
+	assert.Equal(t, []string{"02-202008110034-13"}, deviceIDs(hubMini.InfraredRemotes))
+
+	assert.Equal(t, []string{"PLUG00000001"}, deviceIDs(topology.Standalone))
+	assert.Equal(t, []string{"LOCK00000001"}, deviceIDs(topology.Orphaned))
+	assert.Equal(t, []string{"METER0000001"}, deviceIDs(topology.CloudServiceDisabled))
+
+	node, ok := topology.HubOf("BOT000000001")
+	assert.True(t, ok)
+	assert.Equal(t, "HUB000000001", node.Hub.GetDeviceID())
+	_, ok = topology.HubOf("PLUG00000001")
+	assert.False(t, ok)
+
+	t.Run("Unreachable", func(t *testing.T) {
+		offlineServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"statusCode":171,"body":{},"message":"hub offline"}`))
+		}))
+		defer offlineServer.Close()
+
+		offlineClient := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(offlineServer.URL))
+		_, err := offlineClient.SendCommand("BOT000000001", switchbot.ControlRequest{CommandType: "command", Command: "press", Parameter: "default"})
+		assert.ErrorIs(t, err, switchbot.ErrHubOffline)
+
+		assert.Equal(t,
+			[]string{"HUB000000001", "BOT000000001", "METER0000001", "02-202008110034-14"},
+			deviceIDs(topology.Unreachable(err)),
+		)
+
+		_, err = offlineClient.SendCommand("PLUG00000001", switchbot.ControlRequest{CommandType: "command", Command: "turnOn", Parameter: "default"})
+		assert.ErrorIs(t, err, switchbot.ErrHubOffline)
+		assert.Nil(t, topology.Unreachable(err))
+		assert.Nil(t, topology.Unreachable(switchbot.ErrDeviceOffline))
+	})
+}
@@ -0,0 +1,251 @@
+package switchbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultWatchMaxIntervalFactor is the multiple of the base interval up to which the Watcher backs off by default
+const DefaultWatchMaxIntervalFactor = 8
+
+// StatusChange represents a status field of a device whose value changed between two polls of the Watcher
+type StatusChange struct {
+	DeviceID   string
+	DeviceType string
+	// Field is the JSON name of the status field, such as "lockState"
+	Field string
+	// Old is the value of the field in the previous poll, such as "locked"
+	Old any
+	// New is the value of the field in the current poll, such as "unlocked"
+	New any
+	// Time is the time the change was detected
+	Time time.Time
+}
+
+// WatcherOptions represents the options of the Watcher
+type WatcherOptions struct {
+	// MaxIntervalFactor limits the adaptive backoff. While the status of a device does not change, its interval is doubled
+	// up to this multiple of the base interval, and it goes back to the base interval on a change.
+	// A value of 0 means DefaultWatchMaxIntervalFactor, and 1 disables the backoff.
+	MaxIntervalFactor int
+	// QuotaShare is the share (0.0 to 1.0) of the daily quota the Watcher may use.
+	// The polls of all devices are spaced so that they stay within it. A value of 0 means no limit.
+	QuotaShare float64
+	// DailyQuota is the daily quota QuotaShare refers to. A value of 0 means DefaultDailyQuota.
+	DailyQuota int
+	// OnError is called when the status of a device cannot be retrieved. The device is polled again at its current interval.
+	OnError func(deviceID string, err error)
+}
+
+// Watcher polls the status of devices and emits a StatusChange for each field whose value changes
+type Watcher struct {
+	options WatcherOptions
+	changes chan StatusChange
+
+	mu      sync.Mutex
+	targets []*watchTarget
+	started bool
+}
+
+// watchTarget is a device polled by the Watcher
+type watchTarget struct {
+	device   statusContextGettable
+	interval time.Duration
+	current  time.Duration
+	next     time.Time
+	last     any
+}
+
+// NewWatcher creates a Watcher. Add devices with Watch, then start it with Run.
+func NewWatcher(options WatcherOptions) *Watcher {
+	return &Watcher{
+		options: options,
+		changes: make(chan StatusChange),
+	}
+}
+
+// Watch adds the device to be polled at the interval. It must be called before Run.
+// It returns ErrStatusNotSupported if the status of the device cannot be retrieved, such as for an infrared remote device.
+func (watcher *Watcher) Watch(device DeviceListItem, interval time.Duration) error {
+	gettable, ok := device.(statusContextGettable)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrStatusNotSupported, device.GetDeviceType())
+	}
+	if interval <= 0 {
+		return fmt.Errorf("interval of %s must be positive", device.GetDeviceID())
+	}
+
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	if watcher.started {
+		return errors.New("devices cannot be added after the watcher is started")
+	}
+	watcher.targets = append(watcher.targets, &watchTarget{device: gettable, interval: interval, current: interval})
+	return nil
+}
+
+// Changes returns the channel of the status changes. It is closed when Run returns.
+func (watcher *Watcher) Changes() <-chan StatusChange {
+	return watcher.changes
+}
+
+// Run polls the devices until the context is done, and returns the error of the context.
+// The first poll of each device records its status without emitting changes. Run can be called only once.
+func (watcher *Watcher) Run(ctx context.Context) error {
+	watcher.mu.Lock()
+	if watcher.started {
+		watcher.mu.Unlock()
+		return errors.New("the watcher is already started")
+	}
+	watcher.started = true
+	targets := watcher.targets
+	watcher.mu.Unlock()
+	defer close(watcher.changes)
+
+	pacer := &statusPacer{interval: watcher.quotaInterval()}
+	for {
+		target := nextWatchTarget(targets)
+		if target == nil {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		if err := sleepUntil(ctx, target.next); err != nil {
+			return err
+		}
+		if err := pacer.wait(ctx); err != nil {
+			return err
+		}
+
+		body, err := target.device.GetAnyStatusBodyContext(ctx)
+		polledAt := time.Now()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if watcher.options.OnError != nil {
+				watcher.options.OnError(target.device.GetDeviceID(), err)
+			}
+			target.next = polledAt.Add(target.current)
+			continue
+		}
+
+		var changes []StatusChange
+		if target.last != nil {
+			changes = compareStatusFields(target.device, target.last, body, polledAt)
+			if len(changes) > 0 {
+				target.current = target.interval
+			} else {
+				target.current = watcher.backoff(target)
+			}
+		}
+		target.last = body
+		target.next = polledAt.Add(target.current)
+
+		for _, change := range changes {
+			select {
+			case watcher.changes <- change:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// backoff returns the next interval of the target whose status did not change
+func (watcher *Watcher) backoff(target *watchTarget) time.Duration {
+	factor := watcher.options.MaxIntervalFactor
+	if factor <= 0 {
+		factor = DefaultWatchMaxIntervalFactor
+	}
+	return min(target.current*2, target.interval*time.Duration(factor))
+}
+
+// quotaInterval returns the minimum time between two polls to stay within the QuotaShare of the daily quota
+func (watcher *Watcher) quotaInterval() time.Duration {
+	if watcher.options.QuotaShare <= 0 {
+		return 0
+	}
+	dailyQuota := watcher.options.DailyQuota
+	if dailyQuota <= 0 {
+		dailyQuota = DefaultDailyQuota
+	}
+	budget := watcher.options.QuotaShare * float64(dailyQuota)
+	return time.Duration(float64(24*time.Hour) / budget)
+}
+
+// nextWatchTarget returns the target to be polled first, or nil if there is none
+func nextWatchTarget(targets []*watchTarget) *watchTarget {
+	var next *watchTarget
+	for _, target := range targets {
+		if next == nil || target.next.Before(next.next) {
+			next = target
+		}
+	}
+	return next
+}
+
+// sleepUntil blocks until the time, or until the context is done
+func sleepUntil(ctx context.Context, until time.Time) error {
+	timer := time.NewTimer(time.Until(until))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// compareStatusFields returns a StatusChange for each field whose value differs between the status bodies.
+// The fields are compared in the declaration order, except the fields of CommonDevice.
+func compareStatusFields(device DeviceListItem, oldBody any, newBody any, now time.Time) []StatusChange {
+	oldValue := reflect.Indirect(reflect.ValueOf(oldBody))
+	newValue := reflect.Indirect(reflect.ValueOf(newBody))
+	if oldValue.Kind() != reflect.Struct || oldValue.Type() != newValue.Type() {
+		if reflect.DeepEqual(oldBody, newBody) {
+			return nil
+		}
+		return []StatusChange{{DeviceID: device.GetDeviceID(), DeviceType: device.GetDeviceType(), Old: oldBody, New: newBody, Time: now}}
+	}
+
+	var changes []StatusChange
+	var compare func(oldValue, newValue reflect.Value)
+	compare = func(oldValue, newValue reflect.Value) {
+		structType := oldValue.Type()
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			if field.Anonymous {
+				if field.Type != commonDeviceType && field.Type.Kind() == reflect.Struct {
+					compare(oldValue.Field(i), newValue.Field(i))
+				}
+				continue
+			}
+			tag := field.Tag.Get("json")
+			if tag == "-" || !field.IsExported() {
+				continue
+			}
+			if reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			if name == "" {
+				name = field.Name
+			}
+			changes = append(changes, StatusChange{
+				DeviceID:   device.GetDeviceID(),
+				DeviceType: device.GetDeviceType(),
+				Field:      name,
+				Old:        oldValue.Field(i).Interface(),
+				New:        newValue.Field(i).Interface(),
+				Time:       now,
+			})
+		}
+	}
+	compare(oldValue, newValue)
+	return changes
+}
@@ -0,0 +1,129 @@
+package switchbot_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/switchbottest"
+)
+
+// countingBackend counts the status requests sent to the fake
+type countingBackend struct {
+	*switchbottest.Fake
+	statusCount atomic.Int32
+}
+
+func (backend *countingBackend) GetStatusContext(ctx context.Context, deviceID string, response any) error {
+	backend.statusCount.Add(1)
+	return backend.Fake.GetStatusContext(ctx, deviceID, response)
+}
+
+func newWatcherTestLock(t *testing.T) (*switchbottest.Fake, *countingBackend, *switchbot.LockDevice) {
+	fake := switchbottest.New()
+	fake.AddDevice(
+		&switchbot.LockDevice{CommonDeviceListItem: switchbot.CommonDeviceListItem{
+			CommonDevice: switchbot.CommonDevice{DeviceID: "LOCK00000001", DeviceType: "Smart Lock", HubDeviceId: "HUB000000001"},
+			DeviceName:   "Front Door",
+		}},
+		&switchbot.LockDeviceStatusBody{Battery: 90, LockState: "locked", DoorState: "closed"},
+	)
+	response, err := fake.GetDevices()
+	assert.NoError(t, err)
+	lock, err := switchbot.FindOf[*switchbot.LockDevice](response.Collection(), "LOCK00000001")
+	assert.NoError(t, err)
+	backend := &countingBackend{Fake: fake}
+	lock.Client = backend
+	return fake, backend, lock
+}
+
+func TestWatcher(t *testing.T) {
+	t.Run("EmitsChanges", func(t *testing.T) {
+		fake, _, lock := newWatcherTestLock(t)
+		var errorCount atomic.Int32
+		watcher := switchbot.NewWatcher(switchbot.WatcherOptions{
+			MaxIntervalFactor: 1,
+			OnError: func(deviceID string, err error) {
+				assert.Equal(t, "LOCK00000001", deviceID)
+				assert.ErrorIs(t, err, switchbot.ErrDeviceOffline)
+				errorCount.Add(1)
+			},
+		})
+		assert.NoError(t, watcher.Watch(lock, 5*time.Millisecond))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		done := make(chan error)
+		go func() {
+			done <- watcher.Run(ctx)
+		}()
+
+		// MEMO: Wait for the first poll, which records the status without emitting changes.
+		time.Sleep(50 * time.Millisecond)
+		fake.SetError("LOCK00000001", &switchbot.APIError{StatusCode: switchbot.StatusCodeDeviceOffline})
+		time.Sleep(50 * time.Millisecond)
+		fake.SetError("LOCK00000001", nil)
+		fake.SetStatus("LOCK00000001", &switchbot.LockDeviceStatusBody{Battery: 90, LockState: "unlocked", DoorState: "opened"})
+
+		change := <-watcher.Changes()
+		assert.Equal(t, "LOCK00000001", change.DeviceID)
+		assert.Equal(t, "Smart Lock", change.DeviceType)
+		assert.Equal(t, "lockState", change.Field)
+		assert.Equal(t, "locked", change.Old)
+		assert.Equal(t, "unlocked", change.New)
+		assert.False(t, change.Time.IsZero())
+
+		change = <-watcher.Changes()
+		assert.Equal(t, "doorState", change.Field)
+		assert.Equal(t, "closed", change.Old)
+		assert.Equal(t, "opened", change.New)
+		assert.Greater(t, errorCount.Load(), int32(0))
+
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+		_, ok := <-watcher.Changes()
+		assert.False(t, ok)
+	})
+
+	t.Run("AdaptiveBackoff", func(t *testing.T) {
+		countPolls := func(options switchbot.WatcherOptions) int32 {
+			_, backend, lock := newWatcherTestLock(t)
+			watcher := switchbot.NewWatcher(options)
+			assert.NoError(t, watcher.Watch(lock, 10*time.Millisecond))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+			defer cancel()
+			assert.ErrorIs(t, watcher.Run(ctx), context.DeadlineExceeded)
+			return backend.statusCount.Load()
+		}
+
+		withoutBackoff := countPolls(switchbot.WatcherOptions{MaxIntervalFactor: 1})
+		withBackoff := countPolls(switchbot.WatcherOptions{})
+		assert.Greater(t, withoutBackoff, int32(15))
+		assert.Less(t, withBackoff, int32(10))
+	})
+
+	t.Run("QuotaShare", func(t *testing.T) {
+		_, backend, lock := newWatcherTestLock(t)
+		// MEMO: 10% of 864000 requests per day allows one request every 1 second.
+		watcher := switchbot.NewWatcher(switchbot.WatcherOptions{MaxIntervalFactor: 1, QuotaShare: 0.1, DailyQuota: 864000})
+		assert.NoError(t, watcher.Watch(lock, time.Millisecond))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, watcher.Run(ctx), context.DeadlineExceeded)
+		assert.Equal(t, int32(1), backend.statusCount.Load())
+	})
+
+	t.Run("InvalidDevice", func(t *testing.T) {
+		watcher := switchbot.NewWatcher(switchbot.WatcherOptions{})
+		tv := &switchbot.InfraredRemoteTVDevice{InfraredRemoteDevice: switchbot.InfraredRemoteDevice{DeviceID: "02-202008110034-13", RemoteType: "TV"}}
+		assert.ErrorIs(t, watcher.Watch(tv, time.Second), switchbot.ErrStatusNotSupported)
+
+		_, _, lock := newWatcherTestLock(t)
+		assert.Error(t, watcher.Watch(lock, 0))
+	})
+}
@@ -0,0 +1,154 @@
+package switchbot
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// SetupWebhookRequest represents the request body of `POST /v1.1/webhook/setupWebhook`
+type SetupWebhookRequest struct {
+	Action     string `json:"action"`
+	URL        string `json:"url"`
+	DeviceList string `json:"deviceList"`
+}
+
+// SetupWebhook configures the url that all the webhook events will be sent to
+func (client *Client) SetupWebhook(url string) (*CommonResponse, error) {
+	return client.SetupWebhookContext(context.Background(), url)
+}
+
+// SetupWebhookContext is the same as SetupWebhook, but uses the given context for the request
+func (client *Client) SetupWebhookContext(ctx context.Context, url string) (*CommonResponse, error) {
+	request := SetupWebhookRequest{
+		Action: "setupWebhook",
+		URL:    url,
+		// MEMO: The SwitchBot API currently only supports "ALL".
+		DeviceList: "ALL",
+	}
+	return client.PostRequestContext(ctx, "/webhook/setupWebhook", request)
+}
+
+// QueryWebhookRequest represents the request body of `POST /v1.1/webhook/queryWebhook`
+type QueryWebhookRequest struct {
+	Action string   `json:"action"`
+	URLs   []string `json:"urls,omitempty"`
+}
+
+// QueryWebhookURLResponseBody represents the body of the response to the "queryUrl" action
+type QueryWebhookURLResponseBody struct {
+	URLs []string `json:"urls"`
+}
+
+// QueryWebhookURLResponse represents the response to the "queryUrl" action
+type QueryWebhookURLResponse struct {
+	CommonResponse
+	Body QueryWebhookURLResponseBody `json:"body"`
+}
+
+// QueryWebhookURL retrieves the urls of the current webhook configuration
+func (client *Client) QueryWebhookURL() (*QueryWebhookURLResponse, error) {
+	return client.QueryWebhookURLContext(context.Background())
+}
+
+// QueryWebhookURLContext is the same as QueryWebhookURL, but uses the given context for the request
+func (client *Client) QueryWebhookURLContext(ctx context.Context) (*QueryWebhookURLResponse, error) {
+	request := QueryWebhookRequest{
+		Action: "queryUrl",
+	}
+	response := &QueryWebhookURLResponse{}
+	err := client.PostRequestWithParserContext(ctx, "/webhook/queryWebhook", request, QueryWebhookResponseParser(response))
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// WebhookDetail represents the configuration of a webhook url
+type WebhookDetail struct {
+	URL            string `json:"url"`
+	CreateTime     int64  `json:"createTime"`
+	LastUpdateTime int64  `json:"lastUpdateTime"`
+	DeviceList     string `json:"deviceList"`
+	Enable         bool   `json:"enable"`
+}
+
+// QueryWebhookDetailsResponse represents the response to the "queryDetails" action
+type QueryWebhookDetailsResponse struct {
+	CommonResponse
+	Body []WebhookDetail `json:"body"`
+}
+
+// QueryWebhookDetails retrieves the details of the webhook configuration for the given urls
+func (client *Client) QueryWebhookDetails(urls ...string) (*QueryWebhookDetailsResponse, error) {
+	return client.QueryWebhookDetailsContext(context.Background(), urls...)
+}
+
+// QueryWebhookDetailsContext is the same as QueryWebhookDetails, but uses the given context for the request
+func (client *Client) QueryWebhookDetailsContext(ctx context.Context, urls ...string) (*QueryWebhookDetailsResponse, error) {
+	request := QueryWebhookRequest{
+		Action: "queryDetails",
+		URLs:   urls,
+	}
+	response := &QueryWebhookDetailsResponse{}
+	err := client.PostRequestWithParserContext(ctx, "/webhook/queryWebhook", request, QueryWebhookResponseParser(response))
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// QueryWebhookResponseParser returns a ResponseParser for the responses of `POST /v1.1/webhook/queryWebhook`
+func QueryWebhookResponseParser(response interface{}) ResponseParser {
+	return func(client *Client, bodyBytes []byte) error {
+		return json.Unmarshal(bodyBytes, response)
+	}
+}
+
+// UpdateWebhookConfig represents the configuration to be updated
+type UpdateWebhookConfig struct {
+	URL    string `json:"url"`
+	Enable bool   `json:"enable"`
+}
+
+// UpdateWebhookRequest represents the request body of `POST /v1.1/webhook/updateWebhook`
+type UpdateWebhookRequest struct {
+	Action string              `json:"action"`
+	Config UpdateWebhookConfig `json:"config"`
+}
+
+// UpdateWebhook enables or disables the webhook configuration for the given url
+func (client *Client) UpdateWebhook(url string, enable bool) (*CommonResponse, error) {
+	return client.UpdateWebhookContext(context.Background(), url, enable)
+}
+
+// UpdateWebhookContext is the same as UpdateWebhook, but uses the given context for the request
+func (client *Client) UpdateWebhookContext(ctx context.Context, url string, enable bool) (*CommonResponse, error) {
+	request := UpdateWebhookRequest{
+		Action: "updateWebhook",
+		Config: UpdateWebhookConfig{
+			URL:    url,
+			Enable: enable,
+		},
+	}
+	return client.PostRequestContext(ctx, "/webhook/updateWebhook", request)
+}
+
+// DeleteWebhookRequest represents the request body of `POST /v1.1/webhook/deleteWebhook`
+type DeleteWebhookRequest struct {
+	Action string `json:"action"`
+	URL    string `json:"url"`
+}
+
+// DeleteWebhook deletes the webhook configuration for the given url
+func (client *Client) DeleteWebhook(url string) (*CommonResponse, error) {
+	return client.DeleteWebhookContext(context.Background(), url)
+}
+
+// DeleteWebhookContext is the same as DeleteWebhook, but uses the given context for the request
+func (client *Client) DeleteWebhookContext(ctx context.Context, url string) (*CommonResponse, error) {
+	request := DeleteWebhookRequest{
+		Action: "deleteWebhook",
+		URL:    url,
+	}
+	return client.PostRequestContext(ctx, "/webhook/deleteWebhook", request)
+}
@@ -0,0 +1,281 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Event is an interface implemented by every event received from the SwitchBot webhook
+type Event interface {
+	GetEventType() string
+	GetEventVersion() string
+	GetDeviceType() string
+	GetDeviceMac() string
+}
+
+// Payload represents the request body sent by the SwitchBot webhook
+type Payload struct {
+	EventType    string          `json:"eventType"`
+	EventVersion string          `json:"eventVersion"`
+	Context      json.RawMessage `json:"context"`
+}
+
+// CommonEvent holds the fields shared by all events
+type CommonEvent struct {
+	EventType    string `json:"-"`
+	EventVersion string `json:"-"`
+	DeviceType   string `json:"deviceType"`
+	DeviceMac    string `json:"deviceMac"`
+	TimeOfSample int64  `json:"timeOfSample"`
+}
+
+// GetEventType returns the eventType of the payload (e.g. "changeReport")
+func (event *CommonEvent) GetEventType() string {
+	return event.EventType
+}
+
+// GetEventVersion returns the eventVersion of the payload
+func (event *CommonEvent) GetEventVersion() string {
+	return event.EventVersion
+}
+
+// GetDeviceType returns the deviceType of the context (e.g. "WoLock")
+func (event *CommonEvent) GetDeviceType() string {
+	return event.DeviceType
+}
+
+// GetDeviceMac returns the MAC address of the device, which is the same as the deviceId
+func (event *CommonEvent) GetDeviceMac() string {
+	return event.DeviceMac
+}
+
+// RawEvent represents an event of a device type that is not supported by this package
+type RawEvent struct {
+	CommonEvent
+	Context json.RawMessage `json:"-"`
+}
+
+// BotEvent represents an event from a Bot
+type BotEvent struct {
+	CommonEvent
+	Power      string `json:"power"`
+	Battery    int    `json:"battery"`
+	DeviceMode string `json:"deviceMode"`
+}
+
+// CurtainEvent represents an event from a Curtain / Curtain 3
+type CurtainEvent struct {
+	CommonEvent
+	Calibrate     bool `json:"calibrate"`
+	Group         bool `json:"group"`
+	SlidePosition int  `json:"slidePosition"`
+	Battery       int  `json:"battery"`
+}
+
+// MotionSensorEvent represents an event from a Motion Sensor
+type MotionSensorEvent struct {
+	CommonEvent
+	DetectionState string `json:"detectionState"`
+	Battery        int    `json:"battery"`
+}
+
+// ContactSensorEvent represents an event from a Contact Sensor
+type ContactSensorEvent struct {
+	CommonEvent
+	DetectionState string `json:"detectionState"`
+	DoorMode       string `json:"doorMode"`
+	Brightness     string `json:"brightness"`
+	OpenState      string `json:"openState"`
+	Battery        int    `json:"battery"`
+}
+
+// WaterLeakDetectorEvent represents an event from a Water Leak Detector
+type WaterLeakDetectorEvent struct {
+	CommonEvent
+	DetectionState int `json:"detectionState"`
+	Battery        int `json:"battery"`
+}
+
+// MeterEvent represents an event from a Meter / Meter Plus / Outdoor Meter / Meter Pro / Meter Pro CO2
+type MeterEvent struct {
+	CommonEvent
+	Temperature float64 `json:"temperature"`
+	Scale       string  `json:"scale"`
+	Humidity    int     `json:"humidity"`
+	Battery     int     `json:"battery"`
+	CO2         int     `json:"CO2"`
+}
+
+// Hub2Event represents an event from a Hub 2
+type Hub2Event struct {
+	CommonEvent
+	Temperature float64 `json:"temperature"`
+	Humidity    int     `json:"humidity"`
+	LightLevel  int     `json:"lightLevel"`
+	Scale       string  `json:"scale"`
+}
+
+// LockEvent represents an event from a Lock / Lock Pro / Lock Lite / Lock Ultra
+type LockEvent struct {
+	CommonEvent
+	LockState string `json:"lockState"`
+	Battery   int    `json:"battery"`
+}
+
+// KeypadCreateKeyResultEvent represents the result of the createKey command sent to a Keypad / Keypad Touch
+type KeypadCreateKeyResultEvent struct {
+	CommonEvent
+	EventName string `json:"eventName"`
+	CommandId string `json:"commandId"`
+	Result    string `json:"result"`
+}
+
+// KeypadDeleteKeyResultEvent represents the result of the deleteKey command sent to a Keypad / Keypad Touch
+type KeypadDeleteKeyResultEvent struct {
+	CommonEvent
+	EventName string `json:"eventName"`
+	CommandId string `json:"commandId"`
+	Result    string `json:"result"`
+}
+
+// CeilingLightEvent represents an event from a Ceiling Light / Ceiling Light Pro
+type CeilingLightEvent struct {
+	CommonEvent
+	PowerState       string `json:"powerState"`
+	Brightness       int    `json:"brightness"`
+	ColorTemperature int    `json:"colorTemperature"`
+}
+
+// PlugMiniEvent represents an event from a Plug Mini
+type PlugMiniEvent struct {
+	CommonEvent
+	PowerState string `json:"powerState"`
+}
+
+// StripLightEvent represents an event from a Strip Light
+type StripLightEvent struct {
+	CommonEvent
+	PowerState string `json:"powerState"`
+	Brightness int    `json:"brightness"`
+	Color      string `json:"color"`
+}
+
+// ColorBulbEvent represents an event from a Color Bulb
+type ColorBulbEvent struct {
+	CommonEvent
+	PowerState       string `json:"powerState"`
+	Brightness       int    `json:"brightness"`
+	Color            string `json:"color"`
+	ColorTemperature int    `json:"colorTemperature"`
+}
+
+// RobotVacuumCleanerEvent represents an event from a Robot Vacuum Cleaner
+type RobotVacuumCleanerEvent struct {
+	CommonEvent
+	WorkingStatus string `json:"workingStatus"`
+	OnlineStatus  string `json:"onlineStatus"`
+	Battery       int    `json:"battery"`
+}
+
+// BlindTiltEvent represents an event from a Blind Tilt
+type BlindTiltEvent struct {
+	CommonEvent
+	Version       string `json:"version"`
+	Calibrate     bool   `json:"calibrate"`
+	Group         bool   `json:"group"`
+	Direction     string `json:"direction"`
+	SlidePosition int    `json:"slidePosition"`
+	Battery       int    `json:"battery"`
+}
+
+// ParseEvent parses the request body sent by the SwitchBot webhook into a typed event.
+// Events of an unsupported device type are returned as *RawEvent.
+func ParseEvent(bodyBytes []byte) (Event, error) {
+	payload := Payload{}
+	err := json.Unmarshal(bodyBytes, &payload)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload.Context) == 0 {
+		return nil, fmt.Errorf("context is missing")
+	}
+
+	header := struct {
+		DeviceType string `json:"deviceType"`
+		EventName  string `json:"eventName"`
+	}{}
+	err = json.Unmarshal(payload.Context, &header)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed Event
+	var common *CommonEvent
+	switch header.DeviceType {
+	case "WoHand":
+		event := &BotEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoCurtain", "WoCurtain3":
+		event := &CurtainEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoPresence":
+		event := &MotionSensorEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoContact":
+		event := &ContactSensorEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "Water Detector":
+		event := &WaterLeakDetectorEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoMeter", "WoMeterPlus", "WoIOSensor", "WoMeterPro", "WoMeterProCO2":
+		event := &MeterEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoHub2":
+		event := &Hub2Event{}
+		parsed, common = event, &event.CommonEvent
+	case "WoLock", "WoLockPro", "WoLockLite", "WoLockUltra":
+		event := &LockEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoKeypad", "WoKeypadTouch":
+		switch header.EventName {
+		case "createKey":
+			event := &KeypadCreateKeyResultEvent{}
+			parsed, common = event, &event.CommonEvent
+		case "deleteKey":
+			event := &KeypadDeleteKeyResultEvent{}
+			parsed, common = event, &event.CommonEvent
+		}
+	case "WoCeiling", "WoCeilingPro":
+		event := &CeilingLightEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoPlugUS", "WoPlugJP":
+		event := &PlugMiniEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoStrip":
+		event := &StripLightEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoBulb":
+		event := &ColorBulbEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoSweeper", "WoSweeperPlus", "WoSweeperMini", "WoSweeperMiniPro":
+		event := &RobotVacuumCleanerEvent{}
+		parsed, common = event, &event.CommonEvent
+	case "WoBlindTilt":
+		event := &BlindTiltEvent{}
+		parsed, common = event, &event.CommonEvent
+	}
+
+	if parsed == nil {
+		event := &RawEvent{Context: payload.Context}
+		parsed, common = event, &event.CommonEvent
+	}
+
+	err = json.Unmarshal(payload.Context, parsed)
+	if err != nil {
+		return nil, err
+	}
+	common.EventType = payload.EventType
+	common.EventVersion = payload.EventVersion
+
+	return parsed, nil
+}
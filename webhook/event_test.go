@@ -0,0 +1,136 @@
+package webhook_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yasu89/switch-bot-api-go/webhook"
+)
+
+func TestParseEvent(t *testing.T) {
+	testDataList := []struct {
+		name     string
+		body     string
+		expected webhook.Event
+	}{
+		{
+			name: "LockEvent",
+			body: `{"eventType":"changeReport","eventVersion":"1","context":{"deviceType":"WoLock","deviceMac":"ABCDEF123456","lockState":"LOCKED","battery":90,"timeOfSample":123456789}}`,
+			expected: &webhook.LockEvent{
+				CommonEvent: webhook.CommonEvent{
+					EventType:    "changeReport",
+					EventVersion: "1",
+					DeviceType:   "WoLock",
+					DeviceMac:    "ABCDEF123456",
+					TimeOfSample: 123456789,
+				},
+				LockState: "LOCKED",
+				Battery:   90,
+			},
+		},
+		{
+			name: "MeterEvent",
+			body: `{"eventType":"changeReport","eventVersion":"1","context":{"deviceType":"WoMeterProCO2","deviceMac":"ABCDEF123456","temperature":22.5,"scale":"CELSIUS","humidity":45,"CO2":800,"battery":100,"timeOfSample":123456789}}`,
+			expected: &webhook.MeterEvent{
+				CommonEvent: webhook.CommonEvent{
+					EventType:    "changeReport",
+					EventVersion: "1",
+					DeviceType:   "WoMeterProCO2",
+					DeviceMac:    "ABCDEF123456",
+					TimeOfSample: 123456789,
+				},
+				Temperature: 22.5,
+				Scale:       "CELSIUS",
+				Humidity:    45,
+				Battery:     100,
+				CO2:         800,
+			},
+		},
+		{
+			name: "ContactSensorEvent",
+			body: `{"eventType":"changeReport","eventVersion":"1","context":{"deviceType":"WoContact","deviceMac":"ABCDEF123456","detectionState":"DETECTED","doorMode":"OUT_DOOR","brightness":"dim","openState":"open","battery":80,"timeOfSample":123456789}}`,
+			expected: &webhook.ContactSensorEvent{
+				CommonEvent: webhook.CommonEvent{
+					EventType:    "changeReport",
+					EventVersion: "1",
+					DeviceType:   "WoContact",
+					DeviceMac:    "ABCDEF123456",
+					TimeOfSample: 123456789,
+				},
+				DetectionState: "DETECTED",
+				DoorMode:       "OUT_DOOR",
+				Brightness:     "dim",
+				OpenState:      "open",
+				Battery:        80,
+			},
+		},
+		{
+			name: "KeypadCreateKeyResultEvent",
+			body: `{"eventType":"changeReport","eventVersion":"1","context":{"deviceType":"WoKeypad","deviceMac":"ABCDEF123456","eventName":"createKey","commandId":"CMD-1663558451952-01","result":"success","timeOfSample":123456789}}`,
+			expected: &webhook.KeypadCreateKeyResultEvent{
+				CommonEvent: webhook.CommonEvent{
+					EventType:    "changeReport",
+					EventVersion: "1",
+					DeviceType:   "WoKeypad",
+					DeviceMac:    "ABCDEF123456",
+					TimeOfSample: 123456789,
+				},
+				EventName: "createKey",
+				CommandId: "CMD-1663558451952-01",
+				Result:    "success",
+			},
+		},
+		{
+			name: "KeypadDeleteKeyResultEvent",
+			body: `{"eventType":"changeReport","eventVersion":"1","context":{"deviceType":"WoKeypadTouch","deviceMac":"ABCDEF123456","eventName":"deleteKey","commandId":"CMD-1663558451952-01","result":"failed","timeOfSample":123456789}}`,
+			expected: &webhook.KeypadDeleteKeyResultEvent{
+				CommonEvent: webhook.CommonEvent{
+					EventType:    "changeReport",
+					EventVersion: "1",
+					DeviceType:   "WoKeypadTouch",
+					DeviceMac:    "ABCDEF123456",
+					TimeOfSample: 123456789,
+				},
+				EventName: "deleteKey",
+				CommandId: "CMD-1663558451952-01",
+				Result:    "failed",
+			},
+		},
+		{
+			name: "RawEvent",
+			body: `{"eventType":"changeReport","eventVersion":"1","context":{"deviceType":"WoUnknown","deviceMac":"ABCDEF123456","foo":"bar","timeOfSample":123456789}}`,
+			expected: &webhook.RawEvent{
+				CommonEvent: webhook.CommonEvent{
+					EventType:    "changeReport",
+					EventVersion: "1",
+					DeviceType:   "WoUnknown",
+					DeviceMac:    "ABCDEF123456",
+					TimeOfSample: 123456789,
+				},
+				Context: json.RawMessage(`{"deviceType":"WoUnknown","deviceMac":"ABCDEF123456","foo":"bar","timeOfSample":123456789}`),
+			},
+		},
+	}
+
+	for _, testData := range testDataList {
+		t.Run(testData.name, func(t *testing.T) {
+			event, err := webhook.ParseEvent([]byte(testData.body))
+			assert.NoError(t, err)
+			if !reflect.DeepEqual(event, testData.expected) {
+				t.Fatalf("Expected %#v, got %#v", testData.expected, event)
+			}
+		})
+	}
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := webhook.ParseEvent([]byte(`{`))
+		assert.Error(t, err)
+	})
+
+	t.Run("MissingContext", func(t *testing.T) {
+		_, err := webhook.ParseEvent([]byte(`{"eventType":"changeReport","eventVersion":"1"}`))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxBodyBytes is the maximum size of the request body accepted by Handler
+const maxBodyBytes = 1 << 20
+
+// Handler is an http.Handler that receives events from the SwitchBot webhook
+// and delivers them to the registered callbacks and channels.
+type Handler struct {
+	mu             sync.RWMutex
+	callbacks      []func(Event)
+	channels       []chan<- Event
+	dropCallbacks  []func(Event)
+	panicCallbacks []func(Event, any)
+	running        sync.WaitGroup
+}
+
+// NewHandler creates a new instance of Handler
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// OnEvent registers a callback that is called for every received event.
+// The callbacks are called in a new goroutine, so that a slow callback does not delay the response to SwitchBot.
+// The callbacks of an event are called in the order they were registered, but the callbacks of different events may run concurrently.
+// A panic in a callback is recovered and passed to the OnPanic callbacks.
+func (handler *Handler) OnEvent(callback func(Event)) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	handler.callbacks = append(handler.callbacks, callback)
+}
+
+// Subscribe registers a channel that every received event is sent to.
+// The event is sent without blocking so that a slow subscriber does not delay the response to SwitchBot:
+// if the channel is not ready to receive, the event is dropped for that channel and the OnDrop callbacks are called.
+// Use a buffered channel to absorb bursts of events.
+func (handler *Handler) Subscribe(channel chan<- Event) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	handler.channels = append(handler.channels, channel)
+}
+
+// OnDrop registers a callback that is called when an event is dropped because a subscribed channel was not ready to receive it
+func (handler *Handler) OnDrop(callback func(Event)) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	handler.dropCallbacks = append(handler.dropCallbacks, callback)
+}
+
+// OnPanic registers a callback that is called with the recovered value when a callback registered with OnEvent or On panics
+func (handler *Handler) OnPanic(callback func(event Event, recovered any)) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	handler.panicCallbacks = append(handler.panicCallbacks, callback)
+}
+
+// Wait blocks until the callbacks of the events received so far have returned, such as before shutting down the server
+func (handler *Handler) Wait() {
+	handler.running.Wait()
+}
+
+// On registers a callback that is called only for events of type T. It is called in the same way as the callbacks of OnEvent.
+func On[T Event](handler *Handler, callback func(T)) {
+	handler.OnEvent(func(event Event) {
+		if typed, ok := event.(T); ok {
+			callback(typed)
+		}
+	})
+}
+
+// ServeHTTP parses the request body into an Event and dispatches it
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := ParseEvent(bodyBytes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	handler.mu.RLock()
+	callbacks := handler.callbacks
+	channels := handler.channels
+	dropCallbacks := handler.dropCallbacks
+	panicCallbacks := handler.panicCallbacks
+	handler.mu.RUnlock()
+
+	if len(callbacks) > 0 {
+		handler.running.Add(1)
+		go func() {
+			defer handler.running.Done()
+			for _, callback := range callbacks {
+				runCallback(callback, event, panicCallbacks)
+			}
+		}()
+	}
+	for _, channel := range channels {
+		select {
+		case channel <- event:
+		default:
+			for _, dropCallback := range dropCallbacks {
+				dropCallback(event)
+			}
+		}
+	}
+
+	// MEMO: The event has been accepted even if some subscribers dropped it, so SwitchBot should not retry the delivery.
+	w.WriteHeader(http.StatusOK)
+}
+
+// runCallback calls the callback, and passes the value to the panic callbacks if it panics
+func runCallback(callback func(Event), event Event, panicCallbacks []func(Event, any)) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			for _, panicCallback := range panicCallbacks {
+				panicCallback(event, recovered)
+			}
+		}
+	}()
+	callback(event)
+}
@@ -0,0 +1,140 @@
+package webhook_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yasu89/switch-bot-api-go/webhook"
+)
+
+func TestHandler(t *testing.T) {
+	body := `{"eventType":"changeReport","eventVersion":"1","context":{"deviceType":"WoLock","deviceMac":"ABCDEF123456","lockState":"UNLOCKED","battery":90,"timeOfSample":123456789}}`
+
+	t.Run("Callback", func(t *testing.T) {
+		handler := webhook.NewHandler()
+		var received []webhook.Event
+		handler.OnEvent(func(event webhook.Event) {
+			received = append(received, event)
+		})
+		var lockEvents []*webhook.LockEvent
+		webhook.On(handler, func(event *webhook.LockEvent) {
+			lockEvents = append(lockEvents, event)
+		})
+		var meterEvents []*webhook.MeterEvent
+		webhook.On(handler, func(event *webhook.MeterEvent) {
+			meterEvents = append(meterEvents, event)
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body)))
+		handler.Wait()
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Len(t, received, 1)
+		assert.Len(t, lockEvents, 1)
+		assert.Equal(t, "UNLOCKED", lockEvents[0].LockState)
+		assert.Len(t, meterEvents, 0)
+	})
+
+	t.Run("Channel", func(t *testing.T) {
+		handler := webhook.NewHandler()
+		channel := make(chan webhook.Event, 1)
+		handler.Subscribe(channel)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		event := <-channel
+		assert.Equal(t, "ABCDEF123456", event.GetDeviceMac())
+		assert.IsType(t, &webhook.LockEvent{}, event)
+	})
+
+	t.Run("SlowSubscriber", func(t *testing.T) {
+		handler := webhook.NewHandler()
+		blocked := make(chan webhook.Event)
+		handler.Subscribe(blocked)
+		buffered := make(chan webhook.Event, 1)
+		handler.Subscribe(buffered)
+		var dropped []webhook.Event
+		handler.OnDrop(func(event webhook.Event) {
+			dropped = append(dropped, event)
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Len(t, dropped, 1)
+		assert.Len(t, buffered, 1)
+	})
+
+	t.Run("SlowCallback", func(t *testing.T) {
+		handler := webhook.NewHandler()
+		release := make(chan struct{})
+		done := false
+		handler.OnEvent(func(event webhook.Event) {
+			<-release
+			done = true
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body)))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		close(release)
+		handler.Wait()
+		assert.True(t, done)
+	})
+
+	t.Run("PanickingCallback", func(t *testing.T) {
+		handler := webhook.NewHandler()
+		handler.OnEvent(func(event webhook.Event) {
+			panic("callback failed")
+		})
+		called := false
+		handler.OnEvent(func(event webhook.Event) {
+			called = true
+		})
+		var recovered []any
+		handler.OnPanic(func(event webhook.Event, value any) {
+			assert.Equal(t, "ABCDEF123456", event.GetDeviceMac())
+			recovered = append(recovered, value)
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body)))
+		handler.Wait()
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, []any{"callback failed"}, recovered)
+		assert.True(t, called)
+	})
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		handler := webhook.NewHandler()
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/webhook", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		handler := webhook.NewHandler()
+		called := false
+		handler.OnEvent(func(event webhook.Event) {
+			called = true
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`not json`)))
+		handler.Wait()
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.False(t, called)
+	})
+}
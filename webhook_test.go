@@ -0,0 +1,106 @@
+package switchbot_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	switchbot "github.com/yasu89/switch-bot-api-go"
+	"github.com/yasu89/switch-bot-api-go/helpers"
+)
+
+func TestSetupWebhook(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterSetupWebhookMock(`{"action": "setupWebhook","url": "https://example.com/webhook","deviceList": "ALL"}`)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+
+	response, err := client.SetupWebhook("https://example.com/webhook")
+	assert.NoError(t, err)
+	assertResponse(t, response)
+
+	switchBotMock.AssertCallCount(http.MethodPost, "/webhook/setupWebhook", 1)
+}
+
+func TestQueryWebhookURL(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterQueryWebhookMock([]string{"https://example.com/webhook"}, nil)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+
+	response, err := client.QueryWebhookURL()
+	assert.NoError(t, err)
+	assertResponse(t, &response.CommonResponse)
+	assertBody(t, response.Body, switchbot.QueryWebhookURLResponseBody{
+		URLs: []string{"https://example.com/webhook"},
+	})
+
+	switchBotMock.AssertCallCount(http.MethodPost, "/webhook/queryWebhook", 1)
+}
+
+func TestQueryWebhookDetails(t *testing.T) {
+	details := []switchbot.WebhookDetail{
+		{
+			URL:            "https://example.com/webhook",
+			CreateTime:     123456,
+			LastUpdateTime: 123456,
+			DeviceList:     "ALL",
+			Enable:         true,
+		},
+		{
+			URL:            "https://example.com/other",
+			CreateTime:     234567,
+			LastUpdateTime: 345678,
+			DeviceList:     "ALL",
+			Enable:         false,
+		},
+	}
+
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterQueryWebhookMock(nil, details)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+
+	response, err := client.QueryWebhookDetails("https://example.com/webhook")
+	assert.NoError(t, err)
+	assertResponse(t, &response.CommonResponse)
+	assertBody(t, response.Body, details[:1])
+
+	switchBotMock.AssertCallCount(http.MethodPost, "/webhook/queryWebhook", 1)
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterUpdateWebhookMock(`{"action": "updateWebhook","config": {"url": "https://example.com/webhook","enable": false}}`)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+
+	response, err := client.UpdateWebhook("https://example.com/webhook", false)
+	assert.NoError(t, err)
+	assertResponse(t, response)
+
+	switchBotMock.AssertCallCount(http.MethodPost, "/webhook/updateWebhook", 1)
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	switchBotMock := helpers.NewSwitchBotMock(t)
+	switchBotMock.RegisterDeleteWebhookMock(`{"action": "deleteWebhook","url": "https://example.com/webhook"}`)
+	testServer := switchBotMock.NewTestServer()
+	defer testServer.Close()
+
+	client := switchbot.NewClient("secret", "token", switchbot.OptionBaseApiURL(testServer.URL))
+
+	response, err := client.DeleteWebhook("https://example.com/webhook")
+	assert.NoError(t, err)
+	assertResponse(t, response)
+
+	switchBotMock.AssertCallCount(http.MethodPost, "/webhook/deleteWebhook", 1)
+}